@@ -1,9 +1,14 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
 
+	"github.com/afornagieri/go_api_template/internal/adapter/grpcserver"
 	"github.com/afornagieri/go_api_template/internal/adapter/router"
 	"github.com/afornagieri/go_api_template/internal/infra/di"
 )
@@ -11,12 +16,58 @@ import (
 func main() {
 	container := di.NewContainer()
 
-	r := router.NewRouter(container.ItemController)
+	r := router.NewRouter(router.Controllers{
+		Items:       container.ItemController,
+		Events:      container.EventsController,
+		WebSocket:   container.WebSocket,
+		GraphQL:     container.GraphQLController,
+		Webhooks:    container.WebhookController,
+		Categories:  container.CategoryController,
+		Stock:       container.StockController,
+		Currencies:  container.CurrencyController,
+		Attachments: container.AttachmentController,
+		ItemTypes:   container.ItemTypeController,
+		Docs:        container.DocsController,
+	}, router.Middlewares{
+		RequestValidation: container.RequestValidation,
+		CacheControl:      container.CacheControl,
+		Idempotency:       container.Idempotency,
+	})
+
+	expvar.Publish("item_cache", expvar.Func(func() any { return container.ItemCache.Stats() }))
+
+	if debugAddr := os.Getenv("DEBUG_ADDR"); debugAddr != "" {
+		debug := http.NewServeMux()
+		debug.Handle("/debug/vars", expvar.Handler())
+		go func() {
+			fmt.Printf("Debug server initialized. Running on %s\n", debugAddr)
+			if err := http.ListenAndServe(debugAddr, debug); err != nil {
+				panic(err)
+			}
+		}()
+	}
+
+	go container.Outbox.Run(context.Background())
+	go container.Webhooks.Run(context.Background())
+	go container.Prices.Run(context.Background())
+
+	grpcPort := ":9090"
+	listener, err := net.Listen("tcp", grpcPort)
+	if err != nil {
+		panic(err)
+	}
+	grpcServer := grpcserver.NewServer(container.ItemServer)
+	go func() {
+		fmt.Printf("gRPC server initialized. Running on port %s\n", grpcPort)
+		if err := grpcServer.Serve(listener); err != nil {
+			panic(err)
+		}
+	}()
 
 	port := ":8080"
 	fmt.Printf("Server initialized. Running on port %s\n", port)
 
-	err := http.ListenAndServe(port, r)
+	err = http.ListenAndServe(port, r)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+)
+
+const attachmentFormField = "file"
+
+type AttachmentController struct {
+	UseCase         usecases.AttachmentUseCase
+	Representations *representation.Registry
+}
+
+func NewAttachmentController(useCase usecases.AttachmentUseCase) *AttachmentController {
+	return &AttachmentController{UseCase: useCase, Representations: representation.NewDefaultRegistry()}
+}
+
+func (ctrl *AttachmentController) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	attachments, err := ctrl.UseCase.ListAttachments(chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, attachmentStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, attachments)
+}
+
+func (ctrl *AttachmentController) GetAttachment(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.attachmentID(w, r)
+	if !ok {
+		return
+	}
+	attachment, err := ctrl.UseCase.GetAttachment(chi.URLParam(r, "name"), id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, attachmentStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, attachment)
+}
+
+func (ctrl *AttachmentController) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusUnsupportedMediaType, "uploads must be multipart/form-data")
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			ctrl.Representations.Error(w, r, http.StatusBadRequest, "missing '"+attachmentFormField+"' part")
+			return
+		}
+		if err != nil {
+			status := http.StatusBadRequest
+			if attachmentStatusFor(err) == http.StatusRequestEntityTooLarge {
+				status = http.StatusRequestEntityTooLarge
+			}
+			ctrl.Representations.Error(w, r, status, "failed to read upload: "+err.Error())
+			return
+		}
+		if part.FormName() != attachmentFormField {
+			part.Close()
+			continue
+		}
+
+		name := chi.URLParam(r, "name")
+		attachment, err := ctrl.UseCase.WithActor(actorOf(r)).UploadAttachment(name, part.FileName(), part)
+		part.Close()
+		if err != nil {
+			ctrl.Representations.Error(w, r, attachmentStatusFor(err), err.Error())
+			return
+		}
+		w.Header().Set("Location", "/items/"+url.PathEscape(name)+"/attachments/"+attachment.ID.String())
+		ctrl.Representations.Respond(w, r, http.StatusCreated, attachment)
+		return
+	}
+}
+
+func (ctrl *AttachmentController) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.attachmentID(w, r)
+	if !ok {
+		return
+	}
+	attachment, content, err := ctrl.UseCase.OpenAttachment(chi.URLParam(r, "name"), id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, attachmentStatusFor(err), err.Error())
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("ETag", `"`+attachment.Checksum+`"`)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", attachment.CreatedAt, content)
+}
+
+func (ctrl *AttachmentController) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.attachmentID(w, r)
+	if !ok {
+		return
+	}
+	err := ctrl.UseCase.WithActor(actorOf(r)).DeleteAttachment(chi.URLParam(r, "name"), id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, attachmentStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *AttachmentController) attachmentID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, "id must be a UUID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func attachmentStatusFor(err error) int {
+	var maxErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, entities.ErrItemNotFound), errors.Is(err, entities.ErrAttachmentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, entities.ErrAttachmentTooLarge), errors.As(err, &maxErr):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, entities.ErrUnsupportedAttachment):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, entities.ErrInvalidItem):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
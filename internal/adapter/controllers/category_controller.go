@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+)
+
+type CategoryController struct {
+	UseCase         usecases.CategoryUseCase
+	Representations *representation.Registry
+}
+
+func NewCategoryController(useCase usecases.CategoryUseCase) *CategoryController {
+	return &CategoryController{UseCase: useCase, Representations: representation.NewDefaultRegistry()}
+}
+
+func (ctrl *CategoryController) ListCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := ctrl.UseCase.ListCategories()
+	if err != nil {
+		ctrl.Representations.Error(w, r, categoryStatusFor(err), err.Error())
+		return
+	}
+	if categories == nil {
+		categories = []*category.Category{}
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, categories)
+}
+
+func (ctrl *CategoryController) GetCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	c, err := ctrl.UseCase.GetCategory(id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, categoryStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, c)
+}
+
+func (ctrl *CategoryController) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var input category.Input
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	created, err := ctrl.UseCase.CreateCategory(input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, categoryStatusFor(err), err.Error())
+		return
+	}
+	w.Header().Set("Location", "/categories/"+created.ID.String())
+	ctrl.Representations.Respond(w, r, http.StatusCreated, created)
+}
+
+func (ctrl *CategoryController) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	var input category.Input
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	updated, err := ctrl.UseCase.UpdateCategory(id, input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, categoryStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, updated)
+}
+
+func (ctrl *CategoryController) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	err := ctrl.UseCase.DeleteCategory(id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, categoryStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *CategoryController) ListItems(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	includeDescendants := true
+	if raw := r.URL.Query().Get("descendants"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			ctrl.Representations.Error(w, r, http.StatusBadRequest, "descendants must be a boolean")
+			return
+		}
+		includeDescendants = parsed
+	}
+	items, err := ctrl.UseCase.ListItems(id, includeDescendants)
+	if err != nil {
+		ctrl.Representations.Error(w, r, categoryStatusFor(err), err.Error())
+		return
+	}
+	if items == nil {
+		items = []*entities.Item{}
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, items)
+}
+
+func (ctrl *CategoryController) AddItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	err := ctrl.UseCase.AddItem(id, chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, categoryStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *CategoryController) RemoveItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	err := ctrl.UseCase.RemoveItem(id, chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, categoryStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *CategoryController) pathID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, "id must be a UUID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func categoryStatusFor(err error) int {
+	switch {
+	case errors.Is(err, category.ErrCategoryNotFound), errors.Is(err, category.ErrAssignmentNotFound), errors.Is(err, entities.ErrItemNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, category.ErrCategoryAlreadyExists), errors.Is(err, category.ErrCategoryNotEmpty), errors.Is(err, category.ErrCategoryCycle):
+		return http.StatusConflict
+	case errors.Is(err, category.ErrInvalidCategory):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+)
+
+type CurrencyController struct {
+	UseCase         usecases.CurrencyUseCase
+	Representations *representation.Registry
+}
+
+func NewCurrencyController(useCase usecases.CurrencyUseCase) *CurrencyController {
+	return &CurrencyController{UseCase: useCase, Representations: representation.NewDefaultRegistry()}
+}
+
+func (ctrl *CurrencyController) ListRates(w http.ResponseWriter, r *http.Request) {
+	rates, err := ctrl.UseCase.ListRates()
+	if err != nil {
+		ctrl.Representations.Error(w, r, currencyStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, rates)
+}
+
+func (ctrl *CurrencyController) SetRate(w http.ResponseWriter, r *http.Request) {
+	var input entities.ExchangeRateInput
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	rate, err := ctrl.UseCase.SetRate(chi.URLParam(r, "currency"), input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, currencyStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, rate)
+}
+
+func (ctrl *CurrencyController) DeleteRate(w http.ResponseWriter, r *http.Request) {
+	err := ctrl.UseCase.DeleteRate(chi.URLParam(r, "currency"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, currencyStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *CurrencyController) ListItemPrices(w http.ResponseWriter, r *http.Request) {
+	prices, err := ctrl.UseCase.ListItemPrices(chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, currencyStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, prices)
+}
+
+func (ctrl *CurrencyController) SetItemPrice(w http.ResponseWriter, r *http.Request) {
+	var input entities.CurrencyPriceInput
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	price, err := ctrl.UseCase.SetItemPrice(chi.URLParam(r, "name"), chi.URLParam(r, "currency"), input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, currencyStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, price)
+}
+
+func (ctrl *CurrencyController) DeleteItemPrice(w http.ResponseWriter, r *http.Request) {
+	err := ctrl.UseCase.DeleteItemPrice(chi.URLParam(r, "name"), chi.URLParam(r, "currency"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, currencyStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func currencyStatusFor(err error) int {
+	switch {
+	case errors.Is(err, entities.ErrItemNotFound), errors.Is(err, entities.ErrExchangeRateNotFound), errors.Is(err, entities.ErrCurrencyPriceNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, entities.ErrInvalidItem):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/openapi"
+)
+
+type DocsController struct {
+	Spec   []byte
+	assets http.Handler
+}
+
+func NewDocsController(doc *openapi.Document) *DocsController {
+	spec, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return &DocsController{Spec: spec, assets: http.StripPrefix("/docs/assets/", http.FileServer(http.FS(openapi.SwaggerAssets)))}
+}
+
+func (ctrl *DocsController) GetSpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(ctrl.Spec)
+}
+
+func (ctrl *DocsController) GetSwaggerUI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(openapi.SwaggerUI)
+}
+
+func (ctrl *DocsController) GetSwaggerAsset(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	ctrl.assets.ServeHTTP(w, r)
+}
@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+)
+
+const (
+	sseBuffer      = 256
+	sseRetryMillis = 3000
+)
+
+type EventsController struct {
+	Events    *events.Bus
+	Heartbeat time.Duration
+}
+
+func NewEventsController(bus *events.Bus) *EventsController {
+	return &EventsController{Events: bus, Heartbeat: 15 * time.Second}
+}
+
+func (ctrl *EventsController) StreamItemEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+
+	var missed []events.Event
+	var ch <-chan events.Event
+	var unsubscribe func()
+	complete := true
+
+	if lastEventID != "" {
+		id, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			http.Error(w, "Last-Event-ID must be a numeric event id", http.StatusBadRequest)
+			return
+		}
+		missed, complete, ch, unsubscribe = ctrl.Events.SubscribeFrom(id, sseBuffer)
+	} else {
+		ch, unsubscribe = ctrl.Events.Subscribe(sseBuffer)
+	}
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: %d\n\n", sseRetryMillis)
+	if !complete {
+		fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+	}
+	for _, evt := range missed {
+		if err := writeSSE(w, evt); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(ctrl.Heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case evt, ok := <-ch:
+			if !ok {
+				return
+			}
+			if err := writeSSE(w, evt); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeSSE(w io.Writer, evt events.Event) error {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", evt.ID, evt.Type, data)
+	return err
+}
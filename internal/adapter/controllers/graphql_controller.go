@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/graphqlapi"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+)
+
+const maxGraphQLBatchSize = 10
+
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type GraphQLController struct {
+	UseCase usecases.ItemUseCase
+	Schema  graphql.Schema
+	Limits  graphqlapi.Limits
+	assets  http.Handler
+}
+
+func NewGraphQLController(useCase usecases.ItemUseCase) *GraphQLController {
+	schema, err := graphqlapi.NewSchema(useCase)
+	if err != nil {
+		panic(err)
+	}
+	return &GraphQLController{
+		UseCase: useCase,
+		Schema:  schema,
+		Limits:  graphqlapi.DefaultLimits,
+		assets:  http.StripPrefix("/graphql/assets/", http.FileServer(http.FS(graphqlapi.GraphiQLAssets))),
+	}
+}
+
+func (ctrl *GraphQLController) GetGraphiQLAsset(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	ctrl.assets.ServeHTTP(w, r)
+}
+
+func (ctrl *GraphQLController) Query(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		if strings.Contains(r.Header.Get("Accept"), "text/html") {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write(graphqlapi.GraphiQL)
+			return
+		}
+		req := graphQLRequest{
+			Query:         r.URL.Query().Get("query"),
+			OperationName: r.URL.Query().Get("operationName"),
+		}
+		if vars := r.URL.Query().Get("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				writeGraphQLError(w, http.StatusBadRequest, "variables must be a JSON object")
+				return
+			}
+		}
+		ctrl.writeResult(w, http.StatusOK, ctrl.execute(r, req))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeGraphQLError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var batch []graphQLRequest
+		if err := json.Unmarshal(trimmed, &batch); err != nil {
+			writeGraphQLError(w, http.StatusBadRequest, "malformed batch request")
+			return
+		}
+		if len(batch) == 0 || len(batch) > maxGraphQLBatchSize {
+			writeGraphQLError(w, http.StatusBadRequest, "batch must contain between 1 and 10 operations")
+			return
+		}
+		r = r.WithContext(graphqlapi.WithLoader(r.Context(), ctrl.UseCase))
+		results := make([]*graphql.Result, len(batch))
+		for i, req := range batch {
+			results[i] = ctrl.execute(r, req)
+		}
+		ctrl.writeResult(w, http.StatusOK, results)
+		return
+	}
+
+	var req graphQLRequest
+	if err := json.Unmarshal(trimmed, &req); err != nil {
+		writeGraphQLError(w, http.StatusBadRequest, "malformed request")
+		return
+	}
+	ctrl.writeResult(w, http.StatusOK, ctrl.execute(r, req))
+}
+
+func (ctrl *GraphQLController) execute(r *http.Request, req graphQLRequest) *graphql.Result {
+	if strings.TrimSpace(req.Query) == "" {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{{Message: "query is required"}}}
+	}
+	if err := ctrl.Limits.Check(req.Query, req.Variables); err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{{Message: err.Error()}}}
+	}
+
+	ctx := graphqlapi.WithActor(graphqlapi.WithLoader(r.Context(), ctrl.UseCase), actorOf(r))
+	return graphql.Do(graphql.Params{
+		Schema:         ctrl.Schema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        ctx,
+	})
+}
+
+func (ctrl *GraphQLController) writeResult(w http.ResponseWriter, status int, result any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(result)
+}
+
+func writeGraphQLError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&graphql.Result{Errors: []gqlerrors.FormattedError{{Message: message}}})
+}
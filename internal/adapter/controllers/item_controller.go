@@ -1,80 +1,143 @@
 package controllers
 
 import (
-	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/afornagieri/go_api_template/internal/adapter/httpcache"
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
 	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
 	"github.com/afornagieri/go_api_template/internal/domain/usecases"
 	"github.com/go-chi/chi/v5"
 )
 
+const (
+	ActorHeader          = "X-Actor"
+	attributeParamPrefix = "attr."
+)
+
 type ItemController struct {
-	UseCase usecases.ItemUseCase
+	UseCase         usecases.ItemUseCase
+	Currencies      usecases.CurrencyUseCase
+	Representations *representation.Registry
 }
 
 func NewItemController(useCase usecases.ItemUseCase) *ItemController {
-	return &ItemController{UseCase: useCase}
+	representations := representation.NewDefaultRegistry()
+	representations.Language = entities.DefaultLocale
+	return &ItemController{UseCase: useCase, Representations: representations}
 }
 
 func (ctrl *ItemController) GetItems(w http.ResponseWriter, r *http.Request) {
-	items, err := ctrl.UseCase.GetItems()
+	query, err := itemQueryFrom(r.URL.Query())
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	currency := r.URL.Query().Get("currency")
+	if currency == "" {
+		version, err := ctrl.UseCase.GetCollectionVersion()
+		if err != nil {
+			ctrl.Representations.Error(w, r, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if ctrl.notModified(w, r, version.UpdatedAt, "items", strconv.FormatInt(version.Version, 10), r.URL.Query().Encode(), strings.Join(localeChain(r), ",")) {
+			return
+		}
+	}
+
+	items, err := ctrl.UseCase.ListItems(query)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		ctrl.Representations.Error(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 	if items == nil {
 		items = []*entities.Item{}
 	}
-	json.NewEncoder(w).Encode(items)
+	items, ok := ctrl.localize(w, r, items...)
+	if !ok {
+		return
+	}
+	items, ok = ctrl.inCurrency(w, r, items...)
+	if !ok {
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, items)
 }
 
 func (ctrl *ItemController) GetItemByName(w http.ResponseWriter, r *http.Request) {
 	name := chi.URLParam(r, "name")
 	if name == "" {
-		http.Error(w, "name is required", http.StatusBadRequest)
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, "name is required")
 		return
 	}
 
-	item, err := ctrl.UseCase.GetItemByName(name)
-	if err != nil {
-		if err.Error() == "item not found" {
-			item = nil
-			w.WriteHeader(http.StatusNotFound)
-		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+	if raw := r.URL.Query().Get("at"); raw != "" {
+		at, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			ctrl.Representations.Error(w, r, http.StatusBadRequest, "at must be an RFC 3339 timestamp")
 			return
 		}
+		item, err := ctrl.UseCase.GetItemAt(name, at)
+		if err != nil {
+			ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+			return
+		}
+		ctrl.respondItem(w, r, item)
+		return
+	}
+
+	item, err := ctrl.UseCase.GetItemByName(name)
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	if r.URL.Query().Get("currency") == "" && ctrl.notModified(w, r, item.UpdatedAt, "item", item.ID.String(), strconv.FormatInt(item.UpdatedAt.UnixNano(), 10), strings.Join(localeChain(r), ",")) {
+		return
 	}
-	json.NewEncoder(w).Encode(item)
+	ctrl.respondItem(w, r, item)
 }
 
 func (ctrl *ItemController) CreateItem(w http.ResponseWriter, r *http.Request) {
-	var item *entities.Item
-	err := json.NewDecoder(r.Body).Decode(&item)
+	var item entities.Item
+	err := ctrl.Representations.Bind(r, &item)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		ctrl.Representations.BindError(w, r, err)
 		return
 	}
-	err = ctrl.UseCase.CreateItem(item)
+	created, err := ctrl.UseCase.WithActor(actorOf(r)).CreateItem(&item)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
 		return
 	}
-	w.WriteHeader(http.StatusCreated)
+	w.Header().Set("Location", itemLocation(created))
+	ctrl.Representations.Respond(w, r, http.StatusCreated, created)
 }
 
 func (ctrl *ItemController) UpdateItem(w http.ResponseWriter, r *http.Request) {
 	name := chi.URLParam(r, "name")
-	var item *entities.Item
-	err := json.NewDecoder(r.Body).Decode(&item)
+	var item entities.Item
+	err := ctrl.Representations.Bind(r, &item)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		ctrl.Representations.BindError(w, r, err)
 		return
 	}
-	err = ctrl.UseCase.UpdateItem(name, item)
+	updated, err := ctrl.UseCase.WithActor(actorOf(r)).UpdateItem(name, &item)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	if prefersRepresentation(r) {
+		w.Header().Set("Content-Location", itemLocation(updated))
+		w.Header().Set("Preference-Applied", "return=representation")
+		ctrl.Representations.Respond(w, r, http.StatusOK, updated)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -84,9 +147,205 @@ func (ctrl *ItemController) DeleteItem(w http.ResponseWriter, r *http.Request) {
 	name := chi.URLParam(r, "name")
 	err := ctrl.UseCase.DeleteItem(name)
 	if err != nil {
-		if err.Error() != "item does not exist" {
-			w.WriteHeader(http.StatusNotFound)
-		}
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (ctrl *ItemController) AddTag(w http.ResponseWriter, r *http.Request) {
+	tagged, err := ctrl.UseCase.WithActor(actorOf(r)).AddTag(chi.URLParam(r, "name"), chi.URLParam(r, "tag"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, tagged)
+}
+
+func (ctrl *ItemController) RemoveTag(w http.ResponseWriter, r *http.Request) {
+	untagged, err := ctrl.UseCase.WithActor(actorOf(r)).RemoveTag(chi.URLParam(r, "name"), chi.URLParam(r, "tag"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, untagged)
+}
+
+func (ctrl *ItemController) ListTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := ctrl.UseCase.ListTags()
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, tags)
+}
+
+func (ctrl *ItemController) respondItem(w http.ResponseWriter, r *http.Request, item *entities.Item) {
+	localized, ok := ctrl.localize(w, r, item)
+	if !ok {
+		return
+	}
+	converted, ok := ctrl.inCurrency(w, r, localized...)
+	if !ok {
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, converted[0])
+}
+
+func (ctrl *ItemController) localize(w http.ResponseWriter, r *http.Request, items ...*entities.Item) ([]*entities.Item, bool) {
+	representation.AddVary(w.Header(), "Accept-Language")
+	localized, locales, err := ctrl.UseCase.Localize(items, localeChain(r))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return nil, false
+	}
+	w.Header().Set("Content-Language", strings.Join(locales, ", "))
+	return localized, true
+}
+
+func (ctrl *ItemController) inCurrency(w http.ResponseWriter, r *http.Request, items ...*entities.Item) ([]*entities.Item, bool) {
+	currency := r.URL.Query().Get("currency")
+	if currency == "" {
+		return items, true
+	}
+	if ctrl.Currencies == nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, "currency conversion is not available")
+		return nil, false
+	}
+	converted, err := ctrl.Currencies.Convert(items, currency)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, entities.ErrInvalidItem) || errors.Is(err, entities.ErrExchangeRateNotFound) {
+			status = http.StatusBadRequest
+		}
+		ctrl.Representations.Error(w, r, status, err.Error())
+		return nil, false
+	}
+	return converted, true
+}
+
+func (ctrl *ItemController) notModified(w http.ResponseWriter, r *http.Request, lastModified time.Time, version ...string) bool {
+	codec, err := ctrl.Representations.Negotiate(r.Header.Get("Accept"))
+	if err != nil {
+		return false
+	}
+	representation.AddVary(w.Header(), "Accept")
+	representation.AddVary(w.Header(), "Accept-Language")
+	validators := httpcache.Validators{
+		ETag:         httpcache.ETag(append(version, codec.MediaType())...),
+		LastModified: lastModified,
+	}
+	return httpcache.NotModified(w, r, validators)
+}
+
+func itemQueryFrom(values url.Values) (entities.ItemQuery, error) {
+	var query entities.ItemQuery
+	var err error
+
+	query.SortBy, query.Descending, err = entities.ParseSort(values.Get("sort"))
+	if err != nil {
+		return query, err
+	}
+
+	bounds := []struct {
+		param  string
+		target **time.Time
+	}{
+		{"created_after", &query.CreatedAfter},
+		{"created_before", &query.CreatedBefore},
+		{"updated_after", &query.UpdatedAfter},
+		{"updated_before", &query.UpdatedBefore},
+	}
+	for _, bound := range bounds {
+		raw := values.Get(bound.param)
+		if raw == "" {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return query, fmt.Errorf("%s must be an RFC 3339 timestamp", bound.param)
+		}
+		*bound.target = &at
+	}
+
+	query.CreatedBy = values.Get("created_by")
+	query.UpdatedBy = values.Get("updated_by")
+
+	query.Tags, err = entities.NormalizeTags(values["tag"])
+	if err != nil {
+		return query, err
+	}
+	switch values.Get("tag_match") {
+	case "", "all":
+	case "any":
+		query.MatchAnyTag = true
+	default:
+		return query, errors.New("tag_match must be all or any")
+	}
+
+	query.Statuses, err = entities.ParseStatuses(values["status"])
+	if err != nil {
+		return query, err
+	}
+
+	if raw := values.Get("type"); raw != "" {
+		query.Type, err = entities.NormalizeItemTypeName(raw)
+		if err != nil {
+			return query, err
+		}
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if strings.HasPrefix(key, attributeParamPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range values[key] {
+			filter, err := entities.ParseAttributeFilter(strings.TrimPrefix(key, attributeParamPrefix), value)
+			if err != nil {
+				return query, err
+			}
+			query.Attributes = append(query.Attributes, filter)
+		}
+	}
+	return query, nil
+}
+
+func localeChain(r *http.Request) []string {
+	return entities.LocaleChain(representation.PreferredLanguages(r.Header.Get("Accept-Language")))
+}
+
+func actorOf(r *http.Request) string {
+	return strings.TrimSpace(r.Header.Get(ActorHeader))
+}
+
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, entities.ErrItemNotFound), errors.Is(err, entities.ErrTagNotFound), errors.Is(err, entities.ErrPriceChangeNotFound),
+		errors.Is(err, entities.ErrTranslationNotFound), errors.Is(err, entities.ErrVariantNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, entities.ErrItemAlreadyExists), errors.Is(err, entities.ErrVariantAlreadyExists), errors.Is(err, entities.ErrInvalidTransition):
+		return http.StatusConflict
+	case errors.Is(err, entities.ErrInvalidItem):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func itemLocation(item *entities.Item) string {
+	return "/items/" + url.PathEscape(item.Name)
+}
+
+func prefersRepresentation(r *http.Request) bool {
+	for _, header := range r.Header.Values("Prefer") {
+		for _, preference := range strings.Split(header, ",") {
+			if strings.EqualFold(strings.TrimSpace(preference), "return=representation") {
+				return true
+			}
+		}
+	}
+	return false
+}
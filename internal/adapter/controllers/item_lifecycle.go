@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+func (ctrl *ItemController) PublishItem(w http.ResponseWriter, r *http.Request) {
+	ctrl.transitionItem(w, r, entities.ActionPublish)
+}
+
+func (ctrl *ItemController) UnpublishItem(w http.ResponseWriter, r *http.Request) {
+	ctrl.transitionItem(w, r, entities.ActionUnpublish)
+}
+
+func (ctrl *ItemController) DiscontinueItem(w http.ResponseWriter, r *http.Request) {
+	ctrl.transitionItem(w, r, entities.ActionDiscontinue)
+}
+
+func (ctrl *ItemController) ArchiveItem(w http.ResponseWriter, r *http.Request) {
+	ctrl.transitionItem(w, r, entities.ActionArchive)
+}
+
+func (ctrl *ItemController) RestoreItem(w http.ResponseWriter, r *http.Request) {
+	ctrl.transitionItem(w, r, entities.ActionRestore)
+}
+
+func (ctrl *ItemController) transitionItem(w http.ResponseWriter, r *http.Request, action string) {
+	item, err := ctrl.UseCase.WithActor(actorOf(r)).TransitionItem(chi.URLParam(r, "name"), action)
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	w.Header().Set("Content-Location", itemLocation(item))
+	ctrl.Representations.Respond(w, r, http.StatusOK, item)
+}
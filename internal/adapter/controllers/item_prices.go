@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+func (ctrl *ItemController) ListPriceChanges(w http.ResponseWriter, r *http.Request) {
+	changes, err := ctrl.UseCase.ListPriceChanges(chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	if changes == nil {
+		changes = []*entities.PriceChange{}
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, changes)
+}
+
+func (ctrl *ItemController) GetPriceChange(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.priceChangeID(w, r)
+	if !ok {
+		return
+	}
+	change, err := ctrl.UseCase.GetPriceChange(chi.URLParam(r, "name"), id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, change)
+}
+
+func (ctrl *ItemController) SchedulePriceChange(w http.ResponseWriter, r *http.Request) {
+	var schedule entities.PriceSchedule
+	err := ctrl.Representations.Bind(r, &schedule)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	name := chi.URLParam(r, "name")
+	change, err := ctrl.UseCase.WithActor(actorOf(r)).SchedulePriceChange(name, schedule)
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	w.Header().Set("Location", "/items/"+url.PathEscape(name)+"/prices/"+change.ID.String())
+	ctrl.Representations.Respond(w, r, http.StatusCreated, change)
+}
+
+func (ctrl *ItemController) CancelPriceChange(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.priceChangeID(w, r)
+	if !ok {
+		return
+	}
+	err := ctrl.UseCase.CancelPriceChange(chi.URLParam(r, "name"), id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *ItemController) priceChangeID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, "id must be a UUID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
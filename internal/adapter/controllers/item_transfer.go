@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/transfer"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type importResult struct {
+	XMLName  xml.Name            `json:"-" xml:"import"`
+	Imported int                 `json:"imported" xml:"imported"`
+	Errors   []transfer.RowError `json:"errors,omitempty" xml:"errors>error,omitempty"`
+}
+
+func (ctrl *ItemController) ExportItems(w http.ResponseWriter, r *http.Request) {
+	format, ok := transfer.FormatForAccept(r.Header.Get("Accept"))
+	if !ok {
+		ctrl.Representations.Error(w, r, http.StatusNotAcceptable, "supported formats are text/csv and application/x-ndjson")
+		return
+	}
+
+	statuses, err := entities.ParseStatuses(r.URL.Query()["status"])
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="items.%s"`, format.Extension))
+
+	writer := format.NewWriter(w)
+	flusher, _ := w.(http.Flusher)
+
+	err = ctrl.UseCase.ExportItems(statuses, writer.Write)
+	if err != nil {
+		log.Printf("Failed to export items: %v", err)
+		return
+	}
+	if err := writer.Flush(); err != nil {
+		log.Printf("Failed to flush item export: %v", err)
+		return
+	}
+	if flusher != nil {
+		flusher.Flush()
+	}
+}
+
+func (ctrl *ItemController) ImportItems(w http.ResponseWriter, r *http.Request) {
+	format, ok := transfer.FormatForContentType(r.Header.Get("Content-Type"))
+	if !ok {
+		ctrl.Representations.Error(w, r, http.StatusUnsupportedMediaType, "supported formats are text/csv and application/x-ndjson")
+		return
+	}
+
+	items, rowErrors, err := format.ReadItems(r.Body)
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(rowErrors) > 0 {
+		ctrl.Representations.Respond(w, r, http.StatusUnprocessableEntity, importResult{Errors: rowErrors})
+		return
+	}
+
+	err = ctrl.UseCase.WithActor(actorOf(r)).ImportItems(items)
+	if errors.Is(err, entities.ErrInvalidItem) {
+		ctrl.Representations.Respond(w, r, http.StatusUnprocessableEntity, importResult{Errors: []transfer.RowError{{Error: err.Error()}}})
+		return
+	}
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusCreated, importResult{Imported: len(items)})
+}
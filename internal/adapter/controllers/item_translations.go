@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+func (ctrl *ItemController) ListTranslations(w http.ResponseWriter, r *http.Request) {
+	translations, err := ctrl.UseCase.ListTranslations(chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	if len(translations) > 0 {
+		locales := make([]string, len(translations))
+		for i, translation := range translations {
+			locales[i] = translation.Locale
+		}
+		w.Header().Set("Content-Language", strings.Join(locales, ", "))
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, translations)
+}
+
+func (ctrl *ItemController) GetTranslation(w http.ResponseWriter, r *http.Request) {
+	translation, err := ctrl.UseCase.GetTranslation(chi.URLParam(r, "name"), chi.URLParam(r, "locale"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	w.Header().Set("Content-Language", translation.Locale)
+	ctrl.Representations.Respond(w, r, http.StatusOK, translation)
+}
+
+func (ctrl *ItemController) SetTranslation(w http.ResponseWriter, r *http.Request) {
+	var input entities.TranslationInput
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	translation, err := ctrl.UseCase.WithActor(actorOf(r)).SetTranslation(chi.URLParam(r, "name"), chi.URLParam(r, "locale"), input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	w.Header().Set("Content-Language", translation.Locale)
+	ctrl.Representations.Respond(w, r, http.StatusOK, translation)
+}
+
+func (ctrl *ItemController) DeleteTranslation(w http.ResponseWriter, r *http.Request) {
+	err := ctrl.UseCase.WithActor(actorOf(r)).DeleteTranslation(chi.URLParam(r, "name"), chi.URLParam(r, "locale"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+)
+
+type ItemTypeController struct {
+	UseCase         usecases.ItemTypeUseCase
+	Representations *representation.Registry
+}
+
+func NewItemTypeController(useCase usecases.ItemTypeUseCase) *ItemTypeController {
+	return &ItemTypeController{UseCase: useCase, Representations: representation.NewDefaultRegistry()}
+}
+
+func (ctrl *ItemTypeController) ListItemTypes(w http.ResponseWriter, r *http.Request) {
+	itemTypes, err := ctrl.UseCase.ListItemTypes()
+	if err != nil {
+		ctrl.Representations.Error(w, r, itemTypeStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, itemTypes)
+}
+
+func (ctrl *ItemTypeController) GetItemType(w http.ResponseWriter, r *http.Request) {
+	itemType, err := ctrl.UseCase.GetItemType(chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, itemTypeStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, itemType)
+}
+
+func (ctrl *ItemTypeController) PutItemType(w http.ResponseWriter, r *http.Request) {
+	var input entities.ItemTypeInput
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	itemType, created, err := ctrl.UseCase.WithActor(actorOf(r)).PutItemType(chi.URLParam(r, "name"), input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, itemTypeStatusFor(err), err.Error())
+		return
+	}
+	if created {
+		w.Header().Set("Location", "/item-types/"+url.PathEscape(itemType.Name))
+		ctrl.Representations.Respond(w, r, http.StatusCreated, itemType)
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, itemType)
+}
+
+func (ctrl *ItemTypeController) DeleteItemType(w http.ResponseWriter, r *http.Request) {
+	err := ctrl.UseCase.DeleteItemType(chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, itemTypeStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func itemTypeStatusFor(err error) int {
+	switch {
+	case errors.Is(err, entities.ErrItemTypeNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, entities.ErrItemTypeInUse):
+		return http.StatusConflict
+	case errors.Is(err, entities.ErrInvalidItem):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+func (ctrl *ItemController) ListVariants(w http.ResponseWriter, r *http.Request) {
+	variants, err := ctrl.UseCase.ListVariants(chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, variants)
+}
+
+func (ctrl *ItemController) GetVariant(w http.ResponseWriter, r *http.Request) {
+	variant, err := ctrl.UseCase.GetVariant(chi.URLParam(r, "name"), chi.URLParam(r, "sku"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, variant)
+}
+
+func (ctrl *ItemController) PutVariant(w http.ResponseWriter, r *http.Request) {
+	var input entities.VariantInput
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	name := chi.URLParam(r, "name")
+	variant, created, err := ctrl.UseCase.WithActor(actorOf(r)).PutVariant(name, chi.URLParam(r, "sku"), input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	if created {
+		w.Header().Set("Location", "/items/"+url.PathEscape(name)+"/variants/"+url.PathEscape(variant.SKU))
+		ctrl.Representations.Respond(w, r, http.StatusCreated, variant)
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, variant)
+}
+
+func (ctrl *ItemController) DeleteVariant(w http.ResponseWriter, r *http.Request) {
+	err := ctrl.UseCase.WithActor(actorOf(r)).DeleteVariant(chi.URLParam(r, "name"), chi.URLParam(r, "sku"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, statusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
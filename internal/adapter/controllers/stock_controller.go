@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+)
+
+const (
+	defaultMovementLimit = 50
+	maxMovementLimit     = 500
+)
+
+type StockController struct {
+	UseCase         usecases.StockUseCase
+	Representations *representation.Registry
+}
+
+func NewStockController(useCase usecases.StockUseCase) *StockController {
+	return &StockController{UseCase: useCase, Representations: representation.NewDefaultRegistry()}
+}
+
+func (ctrl *StockController) GetStock(w http.ResponseWriter, r *http.Request) {
+	stock, err := ctrl.UseCase.GetStock(chi.URLParam(r, "name"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, stock)
+}
+
+func (ctrl *StockController) Receive(w http.ResponseWriter, r *http.Request) {
+	var adjustment entities.StockAdjustment
+	err := ctrl.Representations.Bind(r, &adjustment)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	stock, err := ctrl.UseCase.WithActor(actorOf(r)).Receive(chi.URLParam(r, "name"), adjustment)
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, stock)
+}
+
+func (ctrl *StockController) Ship(w http.ResponseWriter, r *http.Request) {
+	var adjustment entities.StockAdjustment
+	err := ctrl.Representations.Bind(r, &adjustment)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	stock, err := ctrl.UseCase.WithActor(actorOf(r)).Ship(chi.URLParam(r, "name"), adjustment)
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, stock)
+}
+
+func (ctrl *StockController) Correct(w http.ResponseWriter, r *http.Request) {
+	var correction entities.StockCorrection
+	err := ctrl.Representations.Bind(r, &correction)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	stock, err := ctrl.UseCase.WithActor(actorOf(r)).Correct(chi.URLParam(r, "name"), correction)
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, stock)
+}
+
+func (ctrl *StockController) ListMovements(w http.ResponseWriter, r *http.Request) {
+	limit := defaultMovementLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxMovementLimit {
+			ctrl.Representations.Error(w, r, http.StatusBadRequest, "limit must be between 1 and 500")
+			return
+		}
+		limit = parsed
+	}
+	movements, err := ctrl.UseCase.ListMovements(chi.URLParam(r, "name"), limit)
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	if movements == nil {
+		movements = []*entities.StockMovement{}
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, movements)
+}
+
+func (ctrl *StockController) Reserve(w http.ResponseWriter, r *http.Request) {
+	var input entities.ReservationInput
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	reservation, err := ctrl.UseCase.WithActor(actorOf(r)).Reserve(chi.URLParam(r, "name"), input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	w.Header().Set("Location", "/reservations/"+reservation.ID.String())
+	ctrl.Representations.Respond(w, r, http.StatusCreated, reservation)
+}
+
+func (ctrl *StockController) GetReservation(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	reservation, err := ctrl.UseCase.GetReservation(id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, reservation)
+}
+
+func (ctrl *StockController) ReleaseReservation(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	err := ctrl.UseCase.ReleaseReservation(id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *StockController) CommitReservation(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	stock, err := ctrl.UseCase.WithActor(actorOf(r)).CommitReservation(id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, stockStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, stock)
+}
+
+func (ctrl *StockController) pathID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, "id must be a UUID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func stockStatusFor(err error) int {
+	switch {
+	case errors.Is(err, entities.ErrItemNotFound), errors.Is(err, entities.ErrReservationNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, entities.ErrInsufficientStock), errors.Is(err, entities.ErrItemNotSellable):
+		return http.StatusConflict
+	case errors.Is(err, entities.ErrInvalidItem):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+)
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 500
+)
+
+type WebhookController struct {
+	UseCase         usecases.WebhookUseCase
+	Representations *representation.Registry
+}
+
+func NewWebhookController(useCase usecases.WebhookUseCase) *WebhookController {
+	return &WebhookController{UseCase: useCase, Representations: representation.NewDefaultRegistry()}
+}
+
+func (ctrl *WebhookController) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	hooks, err := ctrl.UseCase.ListWebhooks()
+	if err != nil {
+		ctrl.Representations.Error(w, r, webhookStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, hooks)
+}
+
+func (ctrl *WebhookController) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	hook, err := ctrl.UseCase.GetWebhook(id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, webhookStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, hook)
+}
+
+func (ctrl *WebhookController) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var input webhook.Input
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	created, err := ctrl.UseCase.CreateWebhook(input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, webhookStatusFor(err), err.Error())
+		return
+	}
+	w.Header().Set("Location", "/webhooks/"+created.ID.String())
+	ctrl.Representations.Respond(w, r, http.StatusCreated, created)
+}
+
+func (ctrl *WebhookController) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	var input webhook.Input
+	err := ctrl.Representations.Bind(r, &input)
+	if err != nil {
+		ctrl.Representations.BindError(w, r, err)
+		return
+	}
+	updated, err := ctrl.UseCase.UpdateWebhook(id, input)
+	if err != nil {
+		ctrl.Representations.Error(w, r, webhookStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, updated)
+}
+
+func (ctrl *WebhookController) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	err := ctrl.UseCase.DeleteWebhook(id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, webhookStatusFor(err), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ctrl *WebhookController) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	limit, ok := ctrl.limit(w, r)
+	if !ok {
+		return
+	}
+	deliveries, err := ctrl.UseCase.ListDeliveries(id, limit)
+	if err != nil {
+		ctrl.Representations.Error(w, r, webhookStatusFor(err), err.Error())
+		return
+	}
+	if deliveries == nil {
+		deliveries = []*webhook.Delivery{}
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, deliveries)
+}
+
+func (ctrl *WebhookController) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	limit, ok := ctrl.limit(w, r)
+	if !ok {
+		return
+	}
+	deliveries, err := ctrl.UseCase.ListDeadLetters(limit)
+	if err != nil {
+		ctrl.Representations.Error(w, r, webhookStatusFor(err), err.Error())
+		return
+	}
+	if deliveries == nil {
+		deliveries = []*webhook.Delivery{}
+	}
+	ctrl.Representations.Respond(w, r, http.StatusOK, deliveries)
+}
+
+func (ctrl *WebhookController) RetryDelivery(w http.ResponseWriter, r *http.Request) {
+	id, ok := ctrl.pathID(w, r)
+	if !ok {
+		return
+	}
+	delivery, err := ctrl.UseCase.RetryDelivery(id)
+	if err != nil {
+		ctrl.Representations.Error(w, r, webhookStatusFor(err), err.Error())
+		return
+	}
+	ctrl.Representations.Respond(w, r, http.StatusAccepted, delivery)
+}
+
+func (ctrl *WebhookController) pathID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, "id must be a UUID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (ctrl *WebhookController) limit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	raw := r.URL.Query().Get("limit")
+	if raw == "" {
+		return defaultDeliveryLimit, true
+	}
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 || limit > maxDeliveryLimit {
+		ctrl.Representations.Error(w, r, http.StatusBadRequest, "limit must be between 1 and 500")
+		return 0, false
+	}
+	return limit, true
+}
+
+func webhookStatusFor(err error) int {
+	switch {
+	case errors.Is(err, webhook.ErrWebhookNotFound), errors.Is(err, webhook.ErrDeliveryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, webhook.ErrInvalidWebhook):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
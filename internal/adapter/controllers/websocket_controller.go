@@ -0,0 +1,263 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+)
+
+const (
+	wsActionSubscribe   = "subscribe"
+	wsActionUnsubscribe = "unsubscribe"
+)
+
+type WebSocketController struct {
+	Events           *events.Bus
+	Upgrader         websocket.Upgrader
+	MaxConnections   int64
+	MaxSubscriptions int
+	MaxMessageSize   int64
+	SendBuffer       int
+	PingInterval     time.Duration
+	PongWait         time.Duration
+	WriteWait        time.Duration
+
+	active atomic.Int64
+}
+
+type wsClientMessage struct {
+	Action string      `json:"action"`
+	Names  []string    `json:"names"`
+	IDs    []uuid.UUID `json:"ids"`
+}
+
+type wsServerMessage struct {
+	Type  string        `json:"type"`
+	Names []string      `json:"names,omitempty"`
+	IDs   []uuid.UUID   `json:"ids,omitempty"`
+	Event *events.Event `json:"event,omitempty"`
+	Error string        `json:"error,omitempty"`
+}
+
+func NewWebSocketController(bus *events.Bus) *WebSocketController {
+	return &WebSocketController{
+		Events:           bus,
+		MaxConnections:   1000,
+		MaxSubscriptions: 1000,
+		MaxMessageSize:   64 << 10,
+		SendBuffer:       256,
+		PingInterval:     30 * time.Second,
+		PongWait:         60 * time.Second,
+		WriteWait:        10 * time.Second,
+	}
+}
+
+func (ctrl *WebSocketController) ActiveConnections() int64 {
+	return ctrl.active.Load()
+}
+
+func (ctrl *WebSocketController) Subscribe(w http.ResponseWriter, r *http.Request) {
+	if ctrl.active.Add(1) > ctrl.MaxConnections {
+		ctrl.active.Add(-1)
+		w.Header().Set("Retry-After", strconv.Itoa(int(ctrl.PingInterval.Seconds())))
+		http.Error(w, "too many websocket connections", http.StatusServiceUnavailable)
+		return
+	}
+	defer ctrl.active.Add(-1)
+
+	conn, err := ctrl.Upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	ch, unsubscribe := ctrl.Events.Subscribe(ctrl.SendBuffer)
+	defer unsubscribe()
+
+	filter := newItemFilter(ctrl.MaxSubscriptions)
+	replies := make(chan wsServerMessage, 16)
+	done := make(chan struct{})
+	go ctrl.readLoop(conn, filter, replies, done)
+
+	ticker := time.NewTicker(ctrl.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case msg := <-replies:
+			if err := ctrl.write(conn, msg); err != nil {
+				return
+			}
+		case evt, ok := <-ch:
+			if !ok {
+				ctrl.close(conn, websocket.CloseTryAgainLater, "subscriber too slow")
+				return
+			}
+			if !filter.matches(evt) {
+				continue
+			}
+			if err := ctrl.write(conn, wsServerMessage{Type: "event", Event: &evt}); err != nil {
+				return
+			}
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(ctrl.WriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (ctrl *WebSocketController) readLoop(conn *websocket.Conn, filter *itemFilter, replies chan<- wsServerMessage, done chan<- struct{}) {
+	defer close(done)
+
+	conn.SetReadLimit(ctrl.MaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(ctrl.PongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(ctrl.PongWait))
+	})
+
+	for {
+		var msg wsClientMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &syntaxErr) && !errors.As(err, &typeErr) {
+				return
+			}
+			if !enqueue(replies, wsServerMessage{Type: "error", Error: "invalid message: " + err.Error()}) {
+				return
+			}
+			continue
+		}
+
+		var reply wsServerMessage
+		switch msg.Action {
+		case wsActionSubscribe:
+			if err := filter.add(msg.Names, msg.IDs); err != nil {
+				reply = wsServerMessage{Type: "error", Error: err.Error()}
+				break
+			}
+			names, ids := filter.snapshot()
+			reply = wsServerMessage{Type: "subscribed", Names: names, IDs: ids}
+		case wsActionUnsubscribe:
+			filter.remove(msg.Names, msg.IDs)
+			names, ids := filter.snapshot()
+			reply = wsServerMessage{Type: "unsubscribed", Names: names, IDs: ids}
+		default:
+			reply = wsServerMessage{Type: "error", Error: "unknown action: " + msg.Action}
+		}
+		if !enqueue(replies, reply) {
+			return
+		}
+	}
+}
+
+func (ctrl *WebSocketController) write(conn *websocket.Conn, msg wsServerMessage) error {
+	conn.SetWriteDeadline(time.Now().Add(ctrl.WriteWait))
+	return conn.WriteJSON(msg)
+}
+
+func (ctrl *WebSocketController) close(conn *websocket.Conn, code int, reason string) {
+	deadline := time.Now().Add(ctrl.WriteWait)
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), deadline)
+}
+
+func enqueue(replies chan<- wsServerMessage, msg wsServerMessage) bool {
+	select {
+	case replies <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+type itemFilter struct {
+	mu    sync.RWMutex
+	limit int
+	names map[string]bool
+	ids   map[uuid.UUID]bool
+}
+
+func newItemFilter(limit int) *itemFilter {
+	return &itemFilter{limit: limit, names: make(map[string]bool), ids: make(map[uuid.UUID]bool)}
+}
+
+func (f *itemFilter) add(names []string, ids []uuid.UUID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	added := 0
+	for _, name := range names {
+		if !f.names[name] {
+			added++
+		}
+	}
+	for _, id := range ids {
+		if !f.ids[id] {
+			added++
+		}
+	}
+	if len(f.names)+len(f.ids)+added > f.limit {
+		return fmt.Errorf("a connection may hold at most %d subscriptions", f.limit)
+	}
+
+	for _, name := range names {
+		f.names[name] = true
+	}
+	for _, id := range ids {
+		f.ids[id] = true
+	}
+	return nil
+}
+
+func (f *itemFilter) remove(names []string, ids []uuid.UUID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, name := range names {
+		delete(f.names, name)
+	}
+	for _, id := range ids {
+		delete(f.ids, id)
+	}
+}
+
+func (f *itemFilter) matches(evt events.Event) bool {
+	if evt.Item == nil {
+		return false
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.names[evt.Item.Name] || f.ids[evt.Item.ID]
+}
+
+func (f *itemFilter) snapshot() ([]string, []uuid.UUID) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	names := make([]string, 0, len(f.names))
+	for name := range f.names {
+		names = append(names, name)
+	}
+	ids := make([]uuid.UUID, 0, len(f.ids))
+	for id := range f.ids {
+		ids = append(ids, id)
+	}
+	sort.Strings(names)
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return names, ids
+}
@@ -0,0 +1,109 @@
+package graphqlapi
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+var DefaultLimits = Limits{MaxDepth: 8, MaxComplexity: 500}
+
+func (l Limits) Check(query string, variables map[string]any) error {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return err
+	}
+
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if frag, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[frag.Name.Value] = frag
+		}
+	}
+
+	w := &walker{fragments: fragments, variables: variables}
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		depth, complexity := w.selectionSet(op.SelectionSet, 0, map[string]bool{})
+		if depth > l.MaxDepth {
+			return fmt.Errorf("query depth %d exceeds the limit of %d", depth, l.MaxDepth)
+		}
+		if complexity > l.MaxComplexity {
+			return fmt.Errorf("query complexity %d exceeds the limit of %d", complexity, l.MaxComplexity)
+		}
+	}
+	return nil
+}
+
+type walker struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+}
+
+func (w *walker) selectionSet(set *ast.SelectionSet, depth int, visiting map[string]bool) (int, int) {
+	if set == nil {
+		return depth, 0
+	}
+
+	maxDepth, complexity := depth, 0
+	for _, selection := range set.Selections {
+		var d, c int
+		switch sel := selection.(type) {
+		case *ast.Field:
+			d, c = w.selectionSet(sel.SelectionSet, depth+1, visiting)
+			c = 1 + c*w.multiplier(sel)
+			if d < depth+1 {
+				d = depth + 1
+			}
+		case *ast.InlineFragment:
+			d, c = w.selectionSet(sel.SelectionSet, depth, visiting)
+		case *ast.FragmentSpread:
+			name := sel.Name.Value
+			frag, ok := w.fragments[name]
+			if !ok || visiting[name] {
+				continue
+			}
+			visiting[name] = true
+			d, c = w.selectionSet(frag.SelectionSet, depth, visiting)
+			delete(visiting, name)
+		}
+		if d > maxDepth {
+			maxDepth = d
+		}
+		complexity += c
+	}
+	return maxDepth, complexity
+}
+
+func (w *walker) multiplier(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(v.Value); err == nil && n > 0 {
+				return n
+			}
+		case *ast.Variable:
+			if n, ok := w.variables[v.Name.Value].(float64); ok && n > 0 {
+				return int(n)
+			}
+		}
+		return 1
+	}
+	if field.Name.Value == "items" {
+		return DefaultPageSize
+	}
+	return 1
+}
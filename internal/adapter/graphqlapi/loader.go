@@ -0,0 +1,87 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/google/uuid"
+)
+
+type loaderKey struct{}
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func actorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+type itemLoader struct {
+	useCase usecases.ItemUseCase
+	mu      sync.Mutex
+	byName  map[string]*entities.Item
+	byID    map[uuid.UUID]*entities.Item
+}
+
+func WithLoader(ctx context.Context, useCase usecases.ItemUseCase) context.Context {
+	if _, ok := ctx.Value(loaderKey{}).(*itemLoader); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, loaderKey{}, newItemLoader(useCase))
+}
+
+func newItemLoader(useCase usecases.ItemUseCase) *itemLoader {
+	return &itemLoader{
+		useCase: useCase,
+		byName:  make(map[string]*entities.Item),
+		byID:    make(map[uuid.UUID]*entities.Item),
+	}
+}
+
+func loaderFrom(ctx context.Context, useCase usecases.ItemUseCase) *itemLoader {
+	if loader, ok := ctx.Value(loaderKey{}).(*itemLoader); ok {
+		return loader
+	}
+	return newItemLoader(useCase)
+}
+
+func (l *itemLoader) ByName(name string) (*entities.Item, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if item, ok := l.byName[name]; ok {
+		return item, nil
+	}
+	item, err := l.useCase.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	l.remember(item)
+	return item, nil
+}
+
+func (l *itemLoader) ByID(id uuid.UUID) (*entities.Item, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if item, ok := l.byID[id]; ok {
+		return item, nil
+	}
+	item, err := l.useCase.GetItemByID(id)
+	if err != nil {
+		return nil, err
+	}
+	l.remember(item)
+	return item, nil
+}
+
+func (l *itemLoader) remember(item *entities.Item) {
+	l.byName[item.Name] = item
+	l.byID[item.ID] = item
+}
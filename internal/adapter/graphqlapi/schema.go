@@ -0,0 +1,301 @@
+package graphqlapi
+
+import (
+	"encoding/base64"
+	"errors"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+type connection struct {
+	Edges    []edge   `json:"edges"`
+	PageInfo pageInfo `json:"pageInfo"`
+}
+
+type edge struct {
+	Cursor string         `json:"cursor"`
+	Node   *entities.Item `json:"node"`
+}
+
+type pageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+func NewSchema(useCase usecases.ItemUseCase) (graphql.Schema, error) {
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*entities.Item).ID.String(), nil
+				},
+			},
+			"name":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"price":       &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"currency":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"description": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"status":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"createdAt": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.DateTime),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*entities.Item).CreatedAt, nil
+				},
+			},
+			"createdBy": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*entities.Item).CreatedBy, nil
+				},
+			},
+			"updatedAt": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.DateTime),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*entities.Item).UpdatedAt, nil
+				},
+			},
+			"updatedBy": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*entities.Item).UpdatedBy, nil
+				},
+			},
+			"tags": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					tags := p.Source.(*entities.Item).Tags
+					if tags == nil {
+						return []string{}, nil
+					}
+					return tags, nil
+				},
+			},
+		},
+	})
+
+	edgeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ItemEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: graphql.NewNonNull(itemType)},
+		},
+	})
+
+	pageInfoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"endCursor":   &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	connectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ItemConnection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edgeType)))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+		},
+	})
+
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ItemFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"nameContains": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"minPrice":     &graphql.InputObjectFieldConfig{Type: graphql.Float},
+			"maxPrice":     &graphql.InputObjectFieldConfig{Type: graphql.Float},
+			"tags":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			"matchAnyTag":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"status":       &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+		},
+	})
+
+	inputType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ItemInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"price":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Float)},
+			"currency":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"description": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"item": &graphql.Field{
+				Type: itemType,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.String},
+					"id":   &graphql.ArgumentConfig{Type: graphql.ID},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					loader := loaderFrom(p.Context, useCase)
+					item, err := lookupItem(loader, p.Args)
+					if errors.Is(err, entities.ErrItemNotFound) {
+						return nil, nil
+					}
+					return item, err
+				},
+			},
+			"items": &graphql.Field{
+				Type: graphql.NewNonNull(connectionType),
+				Args: graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: filterType},
+					"first":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: DefaultPageSize},
+					"after":  &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return listItems(useCase, p.Args)
+				},
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createItem": &graphql.Field{
+				Type: graphql.NewNonNull(itemType),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(inputType)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return useCase.WithActor(actorFrom(p.Context)).CreateItem(itemFromInput(p.Args["input"]))
+				},
+			},
+			"updateItem": &graphql.Field{
+				Type: graphql.NewNonNull(itemType),
+				Args: graphql.FieldConfigArgument{
+					"name":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(inputType)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return useCase.WithActor(actorFrom(p.Context)).UpdateItem(p.Args["name"].(string), itemFromInput(p.Args["input"]))
+				},
+			},
+			"deleteItem": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if err := useCase.DeleteItem(p.Args["name"].(string)); err != nil {
+						return false, err
+					}
+					return true, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query, Mutation: mutation})
+}
+
+func lookupItem(loader *itemLoader, args map[string]any) (*entities.Item, error) {
+	name, hasName := args["name"].(string)
+	rawID, hasID := args["id"].(string)
+	switch {
+	case hasName == hasID:
+		return nil, errors.New("exactly one of name or id must be provided")
+	case hasName:
+		return loader.ByName(name)
+	default:
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, errors.New("id must be a UUID")
+		}
+		return loader.ByID(id)
+	}
+}
+
+func listItems(useCase usecases.ItemUseCase, args map[string]any) (*connection, error) {
+	first, _ := args["first"].(int)
+	if first < 0 {
+		return nil, errors.New("first must not be negative")
+	}
+	if first > MaxPageSize {
+		return nil, errors.New("first must not exceed 100")
+	}
+
+	query := entities.ItemQuery{Limit: first + 1}
+	if after, ok := args["after"].(string); ok && after != "" {
+		name, err := base64.RawURLEncoding.DecodeString(after)
+		if err != nil {
+			return nil, errors.New("invalid cursor")
+		}
+		query.AfterName = string(name)
+	}
+	if filter, ok := args["filter"].(map[string]any); ok {
+		if v, ok := filter["nameContains"].(string); ok {
+			query.NameContains = v
+		}
+		if v, ok := filter["minPrice"].(float64); ok {
+			query.MinPrice = &v
+		}
+		if v, ok := filter["maxPrice"].(float64); ok {
+			query.MaxPrice = &v
+		}
+		if v, ok := filter["tags"].([]any); ok {
+			tags := make([]string, 0, len(v))
+			for _, tag := range v {
+				tags = append(tags, tag.(string))
+			}
+			normalized, err := entities.NormalizeTags(tags)
+			if err != nil {
+				return nil, err
+			}
+			query.Tags = normalized
+		}
+		if v, ok := filter["matchAnyTag"].(bool); ok {
+			query.MatchAnyTag = v
+		}
+		if v, ok := filter["status"].([]any); ok {
+			values := make([]string, 0, len(v))
+			for _, status := range v {
+				values = append(values, status.(string))
+			}
+			statuses, err := entities.ParseStatuses(values)
+			if err != nil {
+				return nil, err
+			}
+			query.Statuses = statuses
+		}
+	}
+
+	items, err := useCase.ListItems(query)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &connection{Edges: []edge{}}
+	if len(items) > first {
+		items = items[:first]
+		conn.PageInfo.HasNextPage = true
+	}
+	for _, item := range items {
+		conn.Edges = append(conn.Edges, edge{Cursor: base64.RawURLEncoding.EncodeToString([]byte(item.Name)), Node: item})
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.EndCursor = conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn, nil
+}
+
+func itemFromInput(raw any) *entities.Item {
+	input, _ := raw.(map[string]any)
+	item := &entities.Item{}
+	item.Name, _ = input["name"].(string)
+	item.Price, _ = input["price"].(float64)
+	item.Currency, _ = input["currency"].(string)
+	item.Description, _ = input["description"].(string)
+	return item
+}
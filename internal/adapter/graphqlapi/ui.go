@@ -0,0 +1,14 @@
+package graphqlapi
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed graphiql.html
+var GraphiQL []byte
+
+//go:embed assets
+var assets embed.FS
+
+var GraphiQLAssets, _ = fs.Sub(assets, "assets")
@@ -0,0 +1,235 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/grpcserver/itemsv1"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+	watchBuffer     = 64
+	actorMetadata   = "x-actor"
+)
+
+type ItemServer struct {
+	itemsv1.UnimplementedItemServiceServer
+	UseCase usecases.ItemUseCase
+	Events  *events.Bus
+}
+
+func NewItemServer(useCase usecases.ItemUseCase, bus *events.Bus) *ItemServer {
+	return &ItemServer{UseCase: useCase, Events: bus}
+}
+
+func (s *ItemServer) GetItem(ctx context.Context, req *itemsv1.GetItemRequest) (*itemsv1.Item, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	item, err := s.UseCase.GetItemByName(req.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProto(item), nil
+}
+
+func (s *ItemServer) ListItems(ctx context.Context, req *itemsv1.ListItemsRequest) (*itemsv1.ListItemsResponse, error) {
+	pageSize := int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	after, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	items, err := s.UseCase.ListItems(entities.ItemQuery{AfterName: after, Limit: pageSize + 1})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &itemsv1.ListItemsResponse{}
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		resp.NextPageToken = encodePageToken(items[pageSize-1].Name)
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, toProto(item))
+	}
+	return resp, nil
+}
+
+func (s *ItemServer) CreateItem(ctx context.Context, req *itemsv1.CreateItemRequest) (*itemsv1.Item, error) {
+	if req.GetItem() == nil {
+		return nil, status.Error(codes.InvalidArgument, "item is required")
+	}
+	created, err := s.UseCase.WithActor(actorFrom(ctx)).CreateItem(fromProto(req.GetItem()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProto(created), nil
+}
+
+func (s *ItemServer) UpdateItem(ctx context.Context, req *itemsv1.UpdateItemRequest) (*itemsv1.Item, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.GetItem() == nil {
+		return nil, status.Error(codes.InvalidArgument, "item is required")
+	}
+	updated, err := s.UseCase.WithActor(actorFrom(ctx)).UpdateItem(req.GetName(), fromProto(req.GetItem()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProto(updated), nil
+}
+
+func (s *ItemServer) DeleteItem(ctx context.Context, req *itemsv1.DeleteItemRequest) (*itemsv1.DeleteItemResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := s.UseCase.DeleteItem(req.GetName()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &itemsv1.DeleteItemResponse{}, nil
+}
+
+func (s *ItemServer) WatchItems(req *itemsv1.WatchItemsRequest, stream itemsv1.ItemService_WatchItemsServer) error {
+	names := make(map[string]bool, len(req.GetNames()))
+	for _, name := range req.GetNames() {
+		names[name] = true
+	}
+
+	ch, unsubscribe := s.Events.Subscribe(watchBuffer)
+	defer unsubscribe()
+
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case evt, ok := <-ch:
+			if !ok {
+				return status.Error(codes.Unavailable, "event stream closed")
+			}
+			if len(names) > 0 && !names[evt.Item.Name] {
+				continue
+			}
+			if err := stream.Send(toProtoEvent(evt)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrItemNotFound), errors.Is(err, entities.ErrTagNotFound), errors.Is(err, entities.ErrPriceChangeNotFound),
+		errors.Is(err, entities.ErrTranslationNotFound), errors.Is(err, entities.ErrVariantNotFound), errors.Is(err, entities.ErrReservationNotFound),
+		errors.Is(err, entities.ErrItemTypeNotFound), errors.Is(err, entities.ErrExchangeRateNotFound), errors.Is(err, entities.ErrCurrencyPriceNotFound),
+		errors.Is(err, entities.ErrAttachmentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entities.ErrItemAlreadyExists), errors.Is(err, entities.ErrVariantAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entities.ErrInvalidTransition), errors.Is(err, entities.ErrInsufficientStock), errors.Is(err, entities.ErrItemNotSellable),
+		errors.Is(err, entities.ErrItemTypeInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entities.ErrInvalidItem), errors.Is(err, entities.ErrUnsupportedAttachment):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrAttachmentTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func actorFrom(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(actorMetadata); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func toProto(item *entities.Item) *itemsv1.Item {
+	return &itemsv1.Item{
+		Id:          item.ID.String(),
+		Name:        item.Name,
+		Price:       item.Price,
+		Description: item.Description,
+		Currency:    item.Currency,
+		Status:      item.Status,
+		CreatedAt:   item.CreatedAt.UTC().Format(time.RFC3339Nano),
+		CreatedBy:   item.CreatedBy,
+		UpdatedAt:   item.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedBy:   item.UpdatedBy,
+	}
+}
+
+func fromProto(item *itemsv1.Item) *entities.Item {
+	id, _ := uuid.Parse(item.GetId())
+	createdAt, _ := time.Parse(time.RFC3339Nano, item.GetCreatedAt())
+	updatedAt, _ := time.Parse(time.RFC3339Nano, item.GetUpdatedAt())
+	return &entities.Item{
+		ID:          id,
+		Name:        item.GetName(),
+		Price:       item.GetPrice(),
+		Description: item.GetDescription(),
+		Currency:    item.GetCurrency(),
+		Status:      item.GetStatus(),
+		CreatedAt:   createdAt,
+		CreatedBy:   item.GetCreatedBy(),
+		UpdatedAt:   updatedAt,
+		UpdatedBy:   item.GetUpdatedBy(),
+	}
+}
+
+var eventTypes = map[events.Type]itemsv1.ItemEvent_Type{
+	events.ItemCreated: itemsv1.ItemEvent_TYPE_CREATED,
+	events.ItemUpdated: itemsv1.ItemEvent_TYPE_UPDATED,
+	events.ItemDeleted: itemsv1.ItemEvent_TYPE_DELETED,
+}
+
+func toProtoEvent(evt events.Event) *itemsv1.ItemEvent {
+	return &itemsv1.ItemEvent{
+		Id:         evt.ID,
+		Type:       eventTypes[evt.Type],
+		Item:       toProto(evt.Item),
+		OccurredAt: evt.OccurredAt.Format(time.RFC3339Nano),
+	}
+}
+
+func encodePageToken(name string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(name))
+}
+
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	name, err := base64.RawURLEncoding.DecodeString(token)
+	return string(name), err
+}
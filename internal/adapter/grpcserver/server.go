@@ -0,0 +1,16 @@
+package grpcserver
+
+//go:generate protoc -I ../../../proto --go_out=. --go_opt=module=github.com/afornagieri/go_api_template/internal/adapter/grpcserver --go-grpc_out=. --go-grpc_opt=module=github.com/afornagieri/go_api_template/internal/adapter/grpcserver items/v1/items.proto
+
+import (
+	"github.com/afornagieri/go_api_template/internal/adapter/grpcserver/itemsv1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func NewServer(itemServer *ItemServer) *grpc.Server {
+	server := grpc.NewServer()
+	itemsv1.RegisterItemServiceServer(server, itemServer)
+	reflection.Register(server)
+	return server
+}
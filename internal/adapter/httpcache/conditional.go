@@ -0,0 +1,75 @@
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type Validators struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func ETag(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+func NotModified(w http.ResponseWriter, r *http.Request, v Validators) bool {
+	if v.ETag != "" {
+		w.Header().Set("ETag", v.ETag)
+	}
+	if !v.LastModified.IsZero() {
+		w.Header().Set("Last-Modified", v.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	if !fresh(r, v) {
+		return false
+	}
+
+	h := w.Header()
+	h.Del("Content-Type")
+	h.Del("Content-Length")
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+func fresh(r *http.Request, v Validators) bool {
+	if inm := r.Header.Values("If-None-Match"); len(inm) > 0 {
+		return v.ETag != "" && matchesAny(strings.Join(inm, ","), v.ETag)
+	}
+
+	ims := r.Header.Get("If-Modified-Since")
+	if ims == "" || v.LastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	return !v.LastModified.Truncate(time.Second).After(since)
+}
+
+func matchesAny(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || weakEqual(candidate, etag) {
+			return true
+		}
+	}
+	return false
+}
+
+func weakEqual(a string, b string) bool {
+	return strings.TrimPrefix(a, "W/") == strings.TrimPrefix(b, "W/")
+}
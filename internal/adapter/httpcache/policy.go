@@ -0,0 +1,53 @@
+package httpcache
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Policy struct {
+	Public               bool
+	Private              bool
+	NoCache              bool
+	NoStore              bool
+	MustRevalidate       bool
+	MaxAge               time.Duration
+	SharedMaxAge         time.Duration
+	StaleWhileRevalidate time.Duration
+}
+
+func (p Policy) String() string {
+	if p.NoStore {
+		return "no-store"
+	}
+
+	var directives []string
+	switch {
+	case p.Private:
+		directives = append(directives, "private")
+	case p.Public:
+		directives = append(directives, "public")
+	}
+	if p.NoCache {
+		directives = append(directives, "no-cache")
+	}
+	directives = append(directives, "max-age="+seconds(p.MaxAge))
+	if p.SharedMaxAge > 0 && !p.Private {
+		directives = append(directives, "s-maxage="+seconds(p.SharedMaxAge))
+	}
+	if p.StaleWhileRevalidate > 0 {
+		directives = append(directives, "stale-while-revalidate="+seconds(p.StaleWhileRevalidate))
+	}
+	if p.MustRevalidate {
+		directives = append(directives, "must-revalidate")
+	}
+	return strings.Join(directives, ", ")
+}
+
+func seconds(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	return strconv.FormatInt(int64(d/time.Second), 10)
+}
@@ -0,0 +1,77 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/httpcache"
+	"github.com/go-chi/chi/v5"
+)
+
+type CacheControl struct {
+	Policies map[string]httpcache.Policy
+}
+
+func NewCacheControl(policies map[string]httpcache.Policy) *CacheControl {
+	return &CacheControl{Policies: policies}
+}
+
+func (m *CacheControl) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		policy, ok := m.policy(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(&cacheControlWriter{ResponseWriter: w, value: policy.String()}, r)
+	})
+}
+
+func (m *CacheControl) policy(r *http.Request) (httpcache.Policy, bool) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return httpcache.Policy{}, false
+	}
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return httpcache.Policy{}, false
+	}
+	policy, ok := m.Policies[r.Method+" "+rctx.RoutePattern()]
+	if !ok && r.Method == http.MethodHead {
+		policy, ok = m.Policies[http.MethodGet+" "+rctx.RoutePattern()]
+	}
+	return policy, ok
+}
+
+type cacheControlWriter struct {
+	http.ResponseWriter
+	value       string
+	wroteHeader bool
+}
+
+func (cw *cacheControlWriter) WriteHeader(code int) {
+	if !cw.wroteHeader {
+		cw.wroteHeader = true
+		if cw.Header().Get("Cache-Control") == "" {
+			if cacheable(code) {
+				cw.Header().Set("Cache-Control", cw.value)
+			} else {
+				cw.Header().Set("Cache-Control", "no-store")
+			}
+		}
+	}
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+func (cw *cacheControlWriter) Write(b []byte) (int, error) {
+	if !cw.wroteHeader {
+		cw.WriteHeader(http.StatusOK)
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+func (cw *cacheControlWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+func cacheable(code int) bool {
+	return code == http.StatusOK || code == http.StatusPartialContent || code == http.StatusNotModified
+}
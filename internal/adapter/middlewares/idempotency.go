@@ -0,0 +1,134 @@
+package middlewares
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+var replayedHeaders = []string{"Content-Type", "Location"}
+
+type Idempotency struct {
+	Repo        repositories.IdempotencyRepository
+	TTL         time.Duration
+	MaxBodySize int64
+}
+
+func NewIdempotency(repo repositories.IdempotencyRepository) *Idempotency {
+	return &Idempotency{Repo: repo, TTL: 24 * time.Hour, MaxBodySize: 10 << 20}
+}
+
+func (m *Idempotency) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > 255 {
+			http.Error(w, "Idempotency-Key must be at most 255 characters", http.StatusBadRequest)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, m.MaxBodySize))
+		if err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		fingerprint := requestFingerprint(r, body)
+		record, err := m.Repo.ReserveKey(key, fingerprint, time.Now().UTC().Add(-m.TTL))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if record != nil {
+			if record.Fingerprint != fingerprint {
+				http.Error(w, "Idempotency-Key was already used with a different request", http.StatusUnprocessableEntity)
+				return
+			}
+			if !record.Completed() {
+				http.Error(w, "a request with this Idempotency-Key is still being processed", http.StatusConflict)
+				return
+			}
+			for name, value := range record.Headers {
+				w.Header().Set(name, value)
+			}
+			w.Header().Set("Idempotent-Replayed", "true")
+			w.WriteHeader(record.StatusCode)
+			w.Write(record.Body)
+			return
+		}
+
+		capture := &responseCapture{ResponseWriter: w}
+		completed := false
+		defer func() {
+			if !completed || capture.status >= http.StatusInternalServerError {
+				if err := m.Repo.ReleaseKey(key); err != nil {
+					log.Printf("Failed to release idempotency key %s: %v", key, err)
+				}
+			}
+		}()
+
+		next.ServeHTTP(capture, r)
+		completed = true
+
+		if capture.status == 0 {
+			capture.status = http.StatusOK
+		}
+		if capture.status >= http.StatusInternalServerError {
+			return
+		}
+
+		headers := make(map[string]string)
+		for _, name := range replayedHeaders {
+			if value := w.Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+		if err := m.Repo.SaveResponse(key, capture.status, headers, capture.body.Bytes()); err != nil {
+			log.Printf("Failed to store idempotent response for key %s: %v", key, err)
+		}
+	})
+}
+
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method)
+	io.WriteString(h, " ")
+	io.WriteString(h, r.URL.Path)
+	io.WriteString(h, "\n")
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type responseCapture struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rc *responseCapture) WriteHeader(code int) {
+	if rc.status == 0 {
+		rc.status = code
+	}
+	rc.ResponseWriter.WriteHeader(code)
+}
+
+func (rc *responseCapture) Write(b []byte) (int, error) {
+	if rc.status == 0 {
+		rc.status = http.StatusOK
+	}
+	rc.body.Write(b)
+	return rc.ResponseWriter.Write(b)
+}
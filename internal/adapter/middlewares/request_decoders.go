@@ -0,0 +1,139 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/openapi"
+)
+
+type xmlBodyNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr    `xml:",any,attr"`
+	Text     string        `xml:",chardata"`
+	Children []xmlBodyNode `xml:",any"`
+}
+
+func decodeXMLBody(doc *openapi.Document, schema *openapi.Schema, raw []byte) (any, error) {
+	var root xmlBodyNode
+	dec := xml.NewDecoder(bytes.NewReader(raw))
+	if err := dec.Decode(&root); err != nil {
+		return nil, err
+	}
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.Comment, xml.ProcInst:
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return nil, errors.New("unexpected data after the root element")
+			}
+		default:
+			return nil, errors.New("unexpected data after the root element")
+		}
+	}
+	return xmlBodyValue(doc, schema, root), nil
+}
+
+func xmlBodyValue(doc *openapi.Document, schema *openapi.Schema, n xmlBodyNode) any {
+	schema = doc.Resolve(schema)
+	text := strings.TrimSpace(n.Text)
+	for _, attr := range n.Attrs {
+		n.Children = append(n.Children, xmlBodyNode{XMLName: attr.Name, Text: attr.Value})
+	}
+
+	kind := ""
+	if schema != nil {
+		kind = schema.Type
+	}
+	switch {
+	case kind == "array":
+		list := make([]any, 0, len(n.Children))
+		for _, child := range n.Children {
+			list = append(list, xmlBodyValue(doc, schema.Items, child))
+		}
+		return list
+	case kind == "object" && len(n.Children) == 0 && text != "":
+		var value any
+		if json.Unmarshal([]byte(text), &value) == nil {
+			return value
+		}
+		return text
+	case kind == "object" || kind == "" && len(n.Children) > 0:
+		obj := make(map[string]any, len(n.Children))
+		for _, child := range n.Children {
+			var prop *openapi.Schema
+			if schema != nil {
+				prop = schema.Properties[child.XMLName.Local]
+			}
+			obj[child.XMLName.Local] = xmlBodyValue(doc, prop, child)
+		}
+		return obj
+	default:
+		return scalarBodyValue(kind, text)
+	}
+}
+
+func decodeCSVBody(doc *openapi.Document, schema *openapi.Schema, raw []byte) (any, error) {
+	reader := csv.NewReader(bytes.NewReader(raw))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) != 2 {
+		return nil, errors.New("csv body must contain a header row and exactly one data row")
+	}
+
+	schema = doc.Resolve(schema)
+	obj := make(map[string]any, len(records[0]))
+	for i, column := range records[0] {
+		name := strings.ToLower(strings.TrimSpace(column))
+		if i >= len(records[1]) || name == "" {
+			continue
+		}
+		value := strings.TrimSpace(records[1][i])
+		if value == "" {
+			continue
+		}
+		kind := ""
+		if schema != nil {
+			if prop := doc.Resolve(schema.Properties[name]); prop != nil {
+				kind = prop.Type
+			}
+		}
+		obj[name] = scalarBodyValue(kind, value)
+	}
+	return obj, nil
+}
+
+func scalarBodyValue(kind string, text string) any {
+	switch kind {
+	case "number", "integer":
+		if num, err := strconv.ParseFloat(text, 64); err == nil {
+			return num
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(text); err == nil {
+			return b
+		}
+	case "object", "array":
+		var value any
+		if json.Unmarshal([]byte(text), &value) == nil {
+			return value
+		}
+	}
+	return text
+}
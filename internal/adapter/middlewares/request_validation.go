@@ -0,0 +1,201 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/openapi"
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	"github.com/go-chi/chi/v5"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type RequestValidation struct {
+	Doc             *openapi.Document
+	Representations *representation.Registry
+	MaxBodySize     int64
+	MaxUploadSize   int64
+	Uploads         map[string]bool
+}
+
+func NewRequestValidation(doc *openapi.Document, representations *representation.Registry) *RequestValidation {
+	return &RequestValidation{
+		Doc:             doc,
+		Representations: representations,
+		MaxBodySize:     1 << 20,
+		MaxUploadSize:   32 << 20,
+		Uploads: map[string]bool{
+			"POST /items/import":             true,
+			"POST /items/{name}/attachments": true,
+		},
+	}
+}
+
+func (m *RequestValidation) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op := m.operation(r)
+		if op == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if details := m.validateParameters(op, r); len(details) > 0 {
+			m.Representations.ErrorWithDetails(w, r, http.StatusBadRequest, "invalid request parameters", details)
+			return
+		}
+
+		if op.RequestBody != nil {
+			if !m.validateBody(w, r, op.RequestBody, m.Uploads[r.Method+" "+chi.RouteContext(r.Context()).RoutePattern()]) {
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *RequestValidation) operation(r *http.Request) *openapi.Operation {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return nil
+	}
+	item, ok := m.Doc.Paths[rctx.RoutePattern()]
+	if !ok {
+		return nil
+	}
+	return item.Operation(r.Method)
+}
+
+func (m *RequestValidation) validateParameters(op *openapi.Operation, r *http.Request) []representation.ErrorDetail {
+	var details []representation.ErrorDetail
+	query := r.URL.Query()
+
+	for _, param := range op.Parameters {
+		var values []string
+		switch param.In {
+		case "path":
+			if v := chi.URLParam(r, param.Name); v != "" {
+				values = []string{v}
+			}
+		case "query":
+			values = query[param.Name]
+		case "header":
+			values = r.Header.Values(param.Name)
+		}
+
+		if len(values) == 0 {
+			if param.Required {
+				details = append(details, representation.ErrorDetail{In: param.In, Pointer: "/" + param.Name, Message: "is required"})
+			}
+			continue
+		}
+		for _, v := range values {
+			details = append(details, m.Doc.ValidateParameter(param, v)...)
+		}
+	}
+	return details
+}
+
+func (m *RequestValidation) validateBody(w http.ResponseWriter, r *http.Request, body *openapi.RequestBody, upload bool) bool {
+	mediaType := "application/json"
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		parsed, _, err := mime.ParseMediaType(ct)
+		if err != nil {
+			m.Representations.Error(w, r, http.StatusUnsupportedMediaType, representation.ErrUnsupportedMediaType.Error())
+			return false
+		}
+		mediaType = parsed
+		if codec, err := m.Representations.ForContentType(ct); err == nil {
+			mediaType = codec.MediaType()
+		}
+	}
+
+	content, ok := body.Content[mediaType]
+	if !ok {
+		m.Representations.Error(w, r, http.StatusUnsupportedMediaType, representation.ErrUnsupportedMediaType.Error())
+		return false
+	}
+
+	if upload {
+		if r.ContentLength > m.MaxUploadSize {
+			m.tooLarge(w, r, m.MaxUploadSize)
+			return false
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, m.MaxUploadSize)
+		return true
+	}
+
+	decode, ok := structuredDecoders[mediaType]
+	if !ok {
+		m.Representations.Error(w, r, http.StatusUnsupportedMediaType, representation.ErrUnsupportedMediaType.Error())
+		return false
+	}
+	limit := m.MaxBodySize
+	if r.ContentLength > limit {
+		m.tooLarge(w, r, limit)
+		return false
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, limit)
+
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			m.tooLarge(w, r, limit)
+			return false
+		}
+		m.Representations.Error(w, r, http.StatusBadRequest, fmt.Sprintf("failed to read request body: %v", err))
+		return false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(raw))
+
+	if len(bytes.TrimSpace(raw)) == 0 {
+		if body.Required {
+			m.Representations.ErrorWithDetails(w, r, http.StatusBadRequest, "request body is required", []representation.ErrorDetail{{In: "body", Pointer: "", Message: "is required"}})
+			return false
+		}
+		return true
+	}
+
+	value, err := decode(m.Doc, content.Schema, raw)
+	if err != nil {
+		m.Representations.Error(w, r, http.StatusBadRequest, fmt.Sprintf("malformed request body: %v", err))
+		return false
+	}
+
+	if details := m.Doc.Validate(content.Schema, value, "body", ""); len(details) > 0 {
+		m.Representations.ErrorWithDetails(w, r, http.StatusUnprocessableEntity, "request body does not match the schema", details)
+		return false
+	}
+	return true
+}
+
+func (m *RequestValidation) tooLarge(w http.ResponseWriter, r *http.Request, limit int64) {
+	m.Representations.Error(w, r, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body must not exceed %d bytes", limit))
+}
+
+var structuredDecoders = map[string]func(doc *openapi.Document, schema *openapi.Schema, raw []byte) (any, error){
+	"application/json": func(doc *openapi.Document, schema *openapi.Schema, raw []byte) (any, error) {
+		var value any
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		if dec.More() {
+			return nil, errors.New("unexpected data after top-level value")
+		}
+		return value, nil
+	},
+	"application/msgpack": func(doc *openapi.Document, schema *openapi.Schema, raw []byte) (any, error) {
+		var value any
+		err := msgpack.Unmarshal(raw, &value)
+		return value, err
+	},
+	"application/xml": decodeXMLBody,
+	"text/csv":        decodeCSVBody,
+}
@@ -0,0 +1,1319 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	"github.com/afornagieri/go_api_template/internal/adapter/transfer"
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+)
+
+const Version = "3.1.0"
+
+func NewDocument(mediaTypes []string) *Document {
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "Items API",
+			Version:     "1.0.0",
+			Description: "Catalogue of items with CSV/NDJSON import and export.",
+		},
+		Paths:      map[string]*PathItem{},
+		Components: Components{Schemas: schemas()},
+	}
+
+	nameParam := &Parameter{Name: "name", In: "path", Required: true, Description: "Item name.", Schema: &Schema{Type: "string", MinLength: intPtr(1)}}
+	idempotencyKey := &Parameter{Name: "Idempotency-Key", In: "header", Description: "Replays the stored response when a request is retried with the same key.", Schema: &Schema{Type: "string", MaxLength: intPtr(255)}}
+	ifNoneMatch := &Parameter{Name: "If-None-Match", In: "header", Description: "Returns 304 when the current ETag matches one of the given tags.", Schema: &Schema{Type: "string"}}
+	ifModifiedSince := &Parameter{Name: "If-Modified-Since", In: "header", Description: "Returns 304 when nothing changed since this HTTP date. Ignored when If-None-Match is sent.", Schema: &Schema{Type: "string"}}
+	actor := &Parameter{Name: "X-Actor", In: "header", Description: "Identity recorded as created_by/updated_by, set by the gateway. Defaults to anonymous.", Schema: &Schema{Type: "string", MaxLength: intPtr(255)}}
+	listParams := []*Parameter{
+		{Name: "sort", In: "query", Description: "Sort key; prefix with - for descending order. Ties are broken by name.", Schema: &Schema{Type: "string", Enum: sortKeys()}},
+		{Name: "created_after", In: "query", Description: "Only items created after this time.", Schema: &Schema{Type: "string", Format: "date-time"}},
+		{Name: "created_before", In: "query", Description: "Only items created before this time.", Schema: &Schema{Type: "string", Format: "date-time"}},
+		{Name: "updated_after", In: "query", Description: "Only items updated after this time.", Schema: &Schema{Type: "string", Format: "date-time"}},
+		{Name: "updated_before", In: "query", Description: "Only items updated before this time.", Schema: &Schema{Type: "string", Format: "date-time"}},
+		{Name: "created_by", In: "query", Description: "Only items created by this actor.", Schema: &Schema{Type: "string"}},
+		{Name: "updated_by", In: "query", Description: "Only items last updated by this actor.", Schema: &Schema{Type: "string"}},
+		{Name: "tag", In: "query", Description: "Only items with this tag. Repeat the parameter to filter by several tags.", Schema: &Schema{Type: "string", MaxLength: intPtr(entities.MaxTagLength)}},
+		{Name: "tag_match", In: "query", Description: "Whether items need all of the given tags or any of them. Defaults to all.", Schema: &Schema{Type: "string", Enum: []any{"all", "any"}}},
+		{Name: "type", In: "query", Description: "Only items of this item type.", Schema: &Schema{Type: "string", MaxLength: intPtr(64)}},
+		{Name: "status", In: "query", Description: statusParamDescription, Schema: &Schema{Type: "string"}},
+	}
+	acceptLanguage := &Parameter{Name: "Accept-Language", In: "header", Description: acceptLanguageDescription, Schema: &Schema{Type: "string"}}
+	currencyParam := &Parameter{Name: "currency", In: "query", Description: currencyParamDescription, Schema: &Schema{Type: "string", MinLength: intPtr(3), MaxLength: intPtr(3)}}
+	prefer := &Parameter{Name: "Prefer", In: "header", Description: "Send return=representation to receive the updated item.", Schema: &Schema{Type: "string"}}
+
+	doc.AddOperation(http.MethodGet, "/items", &Operation{
+		OperationID: "listItems",
+		Summary:     "List items",
+		Description: attributeFilterDescription,
+		Tags:        []string{"items"},
+		Parameters:  append(listParams, currencyParam, acceptLanguage, ifNoneMatch, ifModifiedSince),
+		Responses: map[string]*Response{
+			"200": withHeaders(content("The item catalogue.", mediaTypes, &Schema{Type: "array", Items: Ref("Item")}), localizedHeaders()),
+			"304": withHeaders(&Response{Description: "The catalogue has not changed."}, cacheHeaders()),
+			"400": errorResponse("Invalid sort key, filter or currency, or no exchange rate for the currency."),
+			"406": errorResponse("None of the requested representations is supported."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/items", &Operation{
+		OperationID: "createItem",
+		Summary:     "Create an item",
+		Tags:        []string{"items"},
+		Parameters:  []*Parameter{idempotencyKey, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("ItemInput"))},
+		Responses: map[string]*Response{
+			"201": withHeaders(content("The created item.", mediaTypes, Ref("Item")), map[string]*Header{
+				"Location": {Description: "URL of the created item.", Schema: &Schema{Type: "string"}},
+			}),
+			"400": errorResponse("Malformed request body."),
+			"409": errorResponse("An item with the same name exists, or a request with the same Idempotency-Key is still in progress."),
+			"413": errorResponse("Request body is too large."),
+			"415": errorResponse("Unsupported request content type."),
+			"422": errorResponse("Body does not match the schema, or Idempotency-Key reused with a different payload."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/export", &Operation{
+		OperationID: "exportItems",
+		Summary:     "Stream the catalogue as CSV or NDJSON",
+		Tags:        []string{"transfer"},
+		Parameters: []*Parameter{
+			{Name: "status", In: "query", Description: statusParamDescription, Schema: &Schema{Type: "string"}},
+		},
+		Responses: map[string]*Response{
+			"200": {
+				Description: "The item catalogue.",
+				Content: map[string]*MediaType{
+					transfer.CSV.ContentType:    {Schema: &Schema{Type: "string"}},
+					transfer.NDJSON.ContentType: {Schema: Ref("Item")},
+				},
+			},
+			"400": errorResponse("Invalid status filter."),
+			"406": errorResponse("Requested export format is not supported."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/events", &Operation{
+		OperationID: "streamItemEvents",
+		Summary:     "Stream item.created, item.updated and item.deleted events as Server-Sent Events",
+		Tags:        []string{"events"},
+		Parameters: []*Parameter{
+			{Name: "Last-Event-ID", In: "header", Description: "Resume after this event id. A reset event is sent first when events were lost.", Schema: &Schema{Type: "integer", Minimum: float64Ptr(0)}},
+			{Name: "lastEventId", In: "query", Description: "Same as Last-Event-ID, for clients that cannot set headers.", Schema: &Schema{Type: "integer", Minimum: float64Ptr(0)}},
+		},
+		Responses: map[string]*Response{
+			"200": {Description: "An endless event stream.", Content: map[string]*MediaType{"text/event-stream": {Schema: Ref("ItemEvent")}}},
+			"400": errorResponse("Invalid Last-Event-ID."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/items/import", &Operation{
+		OperationID: "importItems",
+		Summary:     "Import items from CSV or NDJSON",
+		Tags:        []string{"transfer"},
+		Parameters:  []*Parameter{idempotencyKey, actor},
+		RequestBody: &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				transfer.CSV.ContentType:    {Schema: &Schema{Type: "string"}},
+				transfer.NDJSON.ContentType: {Schema: Ref("ItemInput")},
+			},
+		},
+		Responses: map[string]*Response{
+			"201": content("Number of imported items.", mediaTypes, Ref("ImportResult")),
+			"400": errorResponse("The upload could not be parsed."),
+			"409": errorResponse("An item in the upload has the same name as an existing item."),
+			"413": errorResponse("Upload is too large."),
+			"415": errorResponse("Unsupported upload format."),
+			"422": content("Row-level validation errors. Attributes that do not match their item type are reported with line 0 and the item name.", mediaTypes, Ref("ImportResult")),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/{name}", &Operation{
+		OperationID: "getItem",
+		Summary:     "Get an item by name",
+		Tags:        []string{"items"},
+		Parameters: []*Parameter{
+			nameParam,
+			{Name: "at", In: "query", Description: "Return the item with the price in effect at this time, including scheduled prices. Other fields are current. Disables conditional requests.", Schema: &Schema{Type: "string", Format: "date-time"}},
+			currencyParam,
+			acceptLanguage,
+			ifNoneMatch,
+			ifModifiedSince,
+		},
+		Responses: map[string]*Response{
+			"200": withHeaders(content("The item.", mediaTypes, Ref("Item")), localizedHeaders()),
+			"304": withHeaders(&Response{Description: "The item has not changed."}, cacheHeaders()),
+			"400": errorResponse("Invalid at timestamp or currency, or no exchange rate for the currency."),
+			"404": errorResponse("Item not found, or it did not exist at the requested time."),
+			"406": errorResponse("None of the requested representations is supported."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/items/{name}", &Operation{
+		OperationID: "updateItem",
+		Summary:     "Replace an item",
+		Tags:        []string{"items"},
+		Parameters:  []*Parameter{nameParam, prefer, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("ItemInput"))},
+		Responses: map[string]*Response{
+			"200": content("The updated item, when return=representation was preferred.", mediaTypes, Ref("Item")),
+			"204": {Description: "The item was updated."},
+			"400": errorResponse("Malformed request body or invalid parameters."),
+			"409": errorResponse("Another item already has the new name, or the body asks for a different status; use the transition endpoints."),
+			"413": errorResponse("Request body is too large."),
+			"415": errorResponse("Unsupported request content type."),
+			"422": errorResponse("Body does not match the schema."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/items/{name}", &Operation{
+		OperationID: "deleteItem",
+		Summary:     "Delete an item",
+		Tags:        []string{"items"},
+		Parameters:  []*Parameter{nameParam},
+		Responses: map[string]*Response{
+			"204": {Description: "The item was deleted."},
+			"404": errorResponse("Item not found."),
+		},
+	})
+
+	for _, t := range entities.Transitions {
+		doc.AddOperation(http.MethodPost, "/items/{name}/"+t.Action, &Operation{
+			OperationID: t.Action + "Item",
+			Summary:     fmt.Sprintf("Move an item from %s to %s", strings.Join(t.From, " or "), t.To),
+			Tags:        []string{"lifecycle"},
+			Parameters:  []*Parameter{nameParam, idempotencyKey, actor},
+			Responses: map[string]*Response{
+				"200": content("The item in its new status.", mediaTypes, Ref("Item")),
+				"404": errorResponse("Item not found."),
+				"409": errorResponse(fmt.Sprintf("The item is not %s.", strings.Join(t.From, " or "))),
+				"500": errorResponse("Unexpected error."),
+			},
+		})
+	}
+
+	categoryID := &Parameter{Name: "id", In: "path", Required: true, Description: "Category ID.", Schema: &Schema{Type: "string", Format: "uuid"}}
+
+	doc.AddOperation(http.MethodGet, "/categories", &Operation{
+		OperationID: "listCategories",
+		Summary:     "List categories",
+		Description: "Categories are returned flat; use parent_id to rebuild the tree.",
+		Tags:        []string{"categories"},
+		Responses: map[string]*Response{
+			"200": content("Every category, ordered by name.", mediaTypes, &Schema{Type: "array", Items: Ref("Category")}),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/categories", &Operation{
+		OperationID: "createCategory",
+		Summary:     "Create a category",
+		Tags:        []string{"categories"},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("CategoryInput"))},
+		Responses: map[string]*Response{
+			"201": withHeaders(content("The created category.", mediaTypes, Ref("Category")), map[string]*Header{
+				"Location": {Description: "URL of the created category.", Schema: &Schema{Type: "string"}},
+			}),
+			"400": errorResponse("Malformed request body."),
+			"409": errorResponse("A sibling category with the same name exists."),
+			"415": errorResponse("Unsupported request content type."),
+			"422": errorResponse("Body does not match the schema, or the parent does not exist."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/categories/{id}", &Operation{
+		OperationID: "getCategory",
+		Summary:     "Get a category",
+		Tags:        []string{"categories"},
+		Parameters:  []*Parameter{categoryID},
+		Responses: map[string]*Response{
+			"200": content("The category.", mediaTypes, Ref("Category")),
+			"400": errorResponse("Invalid category ID."),
+			"404": errorResponse("Category not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/categories/{id}", &Operation{
+		OperationID: "updateCategory",
+		Summary:     "Rename or move a category",
+		Description: "Omitting parent_id moves the category to the root.",
+		Tags:        []string{"categories"},
+		Parameters:  []*Parameter{categoryID},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("CategoryInput"))},
+		Responses: map[string]*Response{
+			"200": content("The updated category.", mediaTypes, Ref("Category")),
+			"400": errorResponse("Malformed request body or invalid category ID."),
+			"404": errorResponse("Category not found."),
+			"409": errorResponse("A sibling has the same name, or the new parent is the category itself or one of its descendants."),
+			"415": errorResponse("Unsupported request content type."),
+			"422": errorResponse("Body does not match the schema, or the parent does not exist."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/categories/{id}", &Operation{
+		OperationID: "deleteCategory",
+		Summary:     "Delete an empty category",
+		Tags:        []string{"categories"},
+		Parameters:  []*Parameter{categoryID},
+		Responses: map[string]*Response{
+			"204": {Description: "The category was deleted."},
+			"400": errorResponse("Invalid category ID."),
+			"404": errorResponse("Category not found."),
+			"409": errorResponse("The category still has subcategories or items."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/categories/{id}/items", &Operation{
+		OperationID: "listCategoryItems",
+		Summary:     "List the items of a category",
+		Tags:        []string{"categories"},
+		Parameters: []*Parameter{
+			categoryID,
+			{Name: "descendants", In: "query", Description: "Include items of every subcategory. Defaults to true.", Schema: &Schema{Type: "boolean"}},
+		},
+		Responses: map[string]*Response{
+			"200": content("Items ordered by name.", mediaTypes, &Schema{Type: "array", Items: Ref("Item")}),
+			"400": errorResponse("Invalid parameters."),
+			"404": errorResponse("Category not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/categories/{id}/items/{name}", &Operation{
+		OperationID: "addCategoryItem",
+		Summary:     "Assign an item to a category",
+		Tags:        []string{"categories"},
+		Parameters:  []*Parameter{categoryID, nameParam},
+		Responses: map[string]*Response{
+			"204": {Description: "The item is in the category."},
+			"400": errorResponse("Invalid category ID."),
+			"404": errorResponse("Category or item not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/categories/{id}/items/{name}", &Operation{
+		OperationID: "removeCategoryItem",
+		Summary:     "Remove an item from a category",
+		Tags:        []string{"categories"},
+		Parameters:  []*Parameter{categoryID, nameParam},
+		Responses: map[string]*Response{
+			"204": {Description: "The item was removed from the category."},
+			"400": errorResponse("Invalid category ID."),
+			"404": errorResponse("Category or item not found, or the item is not in the category."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	tagParam := &Parameter{Name: "tag", In: "path", Required: true, Description: "Tag; lowercased before it is stored.", Schema: &Schema{Type: "string", MinLength: intPtr(1), MaxLength: intPtr(entities.MaxTagLength)}}
+
+	doc.AddOperation(http.MethodPut, "/items/{name}/tags/{tag}", &Operation{
+		OperationID: "addItemTag",
+		Summary:     "Tag an item",
+		Description: "Adding a tag the item already has leaves it unchanged.",
+		Tags:        []string{"tags"},
+		Parameters:  []*Parameter{nameParam, tagParam, actor},
+		Responses: map[string]*Response{
+			"200": content("The tagged item.", mediaTypes, Ref("Item")),
+			"404": errorResponse("Item not found."),
+			"422": errorResponse("Tags may only contain letters, digits, '-' and '_'."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/items/{name}/tags/{tag}", &Operation{
+		OperationID: "removeItemTag",
+		Summary:     "Remove a tag from an item",
+		Tags:        []string{"tags"},
+		Parameters:  []*Parameter{nameParam, tagParam, actor},
+		Responses: map[string]*Response{
+			"200": content("The item without the tag.", mediaTypes, Ref("Item")),
+			"404": errorResponse("Item not found, or the item does not have the tag."),
+			"422": errorResponse("Tags may only contain letters, digits, '-' and '_'."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/tags", &Operation{
+		OperationID: "listTags",
+		Summary:     "List tags in use",
+		Tags:        []string{"tags"},
+		Responses: map[string]*Response{
+			"200": content("Tags with the number of items carrying them, most used first.", mediaTypes, &Schema{Type: "array", Items: Ref("TagCount")}),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	localeParam := &Parameter{Name: "locale", In: "path", Required: true, Description: "Language tag such as pt or pt-BR.", Schema: &Schema{Type: "string", MinLength: intPtr(2)}}
+
+	doc.AddOperation(http.MethodGet, "/items/{name}/translations", &Operation{
+		OperationID: "listItemTranslations",
+		Summary:     "List the translations of an item",
+		Tags:        []string{"translations"},
+		Parameters:  []*Parameter{nameParam},
+		Responses: map[string]*Response{
+			"200": content("The translations, ordered by locale.", mediaTypes, &Schema{Type: "array", Items: Ref("Translation")}),
+			"404": errorResponse("Item not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/{name}/translations/{locale}", &Operation{
+		OperationID: "getItemTranslation",
+		Summary:     "Get a translation of an item",
+		Tags:        []string{"translations"},
+		Parameters:  []*Parameter{nameParam, localeParam},
+		Responses: map[string]*Response{
+			"200": content("The translation.", mediaTypes, Ref("Translation")),
+			"404": errorResponse("Item not found, or it has no translation for the locale."),
+			"422": errorResponse("Invalid locale."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/items/{name}/translations/{locale}", &Operation{
+		OperationID: "setItemTranslation",
+		Summary:     "Create or replace a translation of an item",
+		Description: "The " + entities.DefaultLocale + " name and description are the ones stored on the item and cannot be set here.",
+		Tags:        []string{"translations"},
+		Parameters:  []*Parameter{nameParam, localeParam, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("TranslationInput"))},
+		Responses: map[string]*Response{
+			"200": content("The translation.", mediaTypes, Ref("Translation")),
+			"400": errorResponse("Malformed request body."),
+			"404": errorResponse("Item not found."),
+			"422": errorResponse("Invalid locale, the default locale, or a missing name or description."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/items/{name}/translations/{locale}", &Operation{
+		OperationID: "deleteItemTranslation",
+		Summary:     "Delete a translation of an item",
+		Tags:        []string{"translations"},
+		Parameters:  []*Parameter{nameParam, localeParam, actor},
+		Responses: map[string]*Response{
+			"204": {Description: "The translation was deleted."},
+			"404": errorResponse("Item not found, or it has no translation for the locale."),
+			"422": errorResponse("Invalid locale."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	skuParam := &Parameter{Name: "sku", In: "path", Required: true, Description: "Stock keeping unit, matched case-insensitively.", Schema: &Schema{Type: "string", MinLength: intPtr(1), MaxLength: intPtr(64)}}
+
+	doc.AddOperation(http.MethodGet, "/items/{name}/variants", &Operation{
+		OperationID: "listItemVariants",
+		Summary:     "List the variants of an item",
+		Tags:        []string{"variants"},
+		Parameters:  []*Parameter{nameParam},
+		Responses: map[string]*Response{
+			"200": content("The variants, ordered by SKU.", mediaTypes, &Schema{Type: "array", Items: Ref("Variant")}),
+			"404": errorResponse("Item not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/{name}/variants/{sku}", &Operation{
+		OperationID: "getItemVariant",
+		Summary:     "Get a variant of an item",
+		Tags:        []string{"variants"},
+		Parameters:  []*Parameter{nameParam, skuParam},
+		Responses: map[string]*Response{
+			"200": content("The variant.", mediaTypes, Ref("Variant")),
+			"404": errorResponse("Item not found, or it has no variant with the SKU."),
+			"422": errorResponse("Invalid SKU."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/items/{name}/variants/{sku}", &Operation{
+		OperationID: "putItemVariant",
+		Summary:     "Create or replace a variant of an item",
+		Description: "SKUs are stored in upper case and are unique across all items.",
+		Tags:        []string{"variants"},
+		Parameters:  []*Parameter{nameParam, skuParam, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("VariantInput"))},
+		Responses: map[string]*Response{
+			"200": content("The variant was replaced.", mediaTypes, Ref("Variant")),
+			"201": content("The variant was created.", mediaTypes, Ref("Variant")),
+			"400": errorResponse("Malformed request body."),
+			"404": errorResponse("Item not found."),
+			"409": errorResponse("The SKU belongs to another item, or another variant already has the same options."),
+			"422": errorResponse("Invalid SKU, options or price, or option names that differ from the other variants."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/items/{name}/variants/{sku}", &Operation{
+		OperationID: "deleteItemVariant",
+		Summary:     "Delete a variant of an item",
+		Tags:        []string{"variants"},
+		Parameters:  []*Parameter{nameParam, skuParam, actor},
+		Responses: map[string]*Response{
+			"204": {Description: "The variant was deleted."},
+			"404": errorResponse("Item not found, or it has no variant with the SKU."),
+			"422": errorResponse("Invalid SKU."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	attachmentID := &Parameter{Name: "id", In: "path", Required: true, Description: "Attachment ID.", Schema: &Schema{Type: "string", Format: "uuid"}}
+
+	doc.AddOperation(http.MethodGet, "/items/{name}/attachments", &Operation{
+		OperationID: "listItemAttachments",
+		Summary:     "List the attachments of an item",
+		Tags:        []string{"attachments"},
+		Parameters:  []*Parameter{nameParam},
+		Responses: map[string]*Response{
+			"200": content("The attachments, oldest first.", mediaTypes, &Schema{Type: "array", Items: Ref("Attachment")}),
+			"404": errorResponse("Item not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/items/{name}/attachments", &Operation{
+		OperationID: "uploadItemAttachment",
+		Summary:     "Upload an attachment for an item",
+		Description: "The content type is detected from the uploaded bytes; the type sent by the client is ignored. Allowed types: " +
+			strings.Join(entities.AttachmentTypes, ", ") + ".",
+		Tags:       []string{"attachments"},
+		Parameters: []*Parameter{nameParam, actor},
+		RequestBody: &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				"multipart/form-data": {Schema: &Schema{
+					Type:       "object",
+					Required:   []string{"file"},
+					Properties: map[string]*Schema{"file": {Type: "string", Format: "binary", MaxLength: intPtr(int(entities.MaxAttachmentSize))}},
+				}},
+			},
+		},
+		Responses: map[string]*Response{
+			"201": withHeaders(content("The stored attachment.", mediaTypes, Ref("Attachment")), map[string]*Header{
+				"Location": {Description: "URL of the attachment.", Schema: &Schema{Type: "string"}},
+			}),
+			"400": errorResponse("Malformed upload or missing file part."),
+			"404": errorResponse("Item not found."),
+			"413": errorResponse("The file is too large."),
+			"415": errorResponse("The upload is not multipart/form-data, or the file type is not allowed."),
+			"422": errorResponse("Empty file or invalid filename."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/{name}/attachments/{id}", &Operation{
+		OperationID: "getItemAttachment",
+		Summary:     "Get the metadata of an attachment",
+		Tags:        []string{"attachments"},
+		Parameters:  []*Parameter{nameParam, attachmentID},
+		Responses: map[string]*Response{
+			"200": content("The attachment.", mediaTypes, Ref("Attachment")),
+			"400": errorResponse("Invalid attachment ID."),
+			"404": errorResponse("Item or attachment not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/{name}/attachments/{id}/content", &Operation{
+		OperationID: "downloadItemAttachment",
+		Summary:     "Download an attachment",
+		Description: "Supports single and multiple byte ranges, If-Range and If-None-Match against the checksum ETag.",
+		Tags:        []string{"attachments"},
+		Parameters: []*Parameter{
+			nameParam,
+			attachmentID,
+			{Name: "Range", In: "header", Description: "Byte ranges to return, such as bytes=0-1023.", Schema: &Schema{Type: "string"}},
+			{Name: "If-Range", In: "header", Description: "Only honour Range when the ETag still matches.", Schema: &Schema{Type: "string"}},
+			ifNoneMatch,
+		},
+		Responses: map[string]*Response{
+			"200": withHeaders(attachmentContent("The attachment content."), attachmentHeaders()),
+			"206": withHeaders(attachmentContent("The requested ranges; multiple ranges are sent as multipart/byteranges."), attachmentHeaders()),
+			"304": {Description: "The attachment matches If-None-Match."},
+			"400": errorResponse("Invalid attachment ID."),
+			"404": errorResponse("Item or attachment not found."),
+			"416": {Description: "The requested range cannot be satisfied."},
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/items/{name}/attachments/{id}", &Operation{
+		OperationID: "deleteItemAttachment",
+		Summary:     "Delete an attachment and its content",
+		Tags:        []string{"attachments"},
+		Parameters:  []*Parameter{nameParam, attachmentID, actor},
+		Responses: map[string]*Response{
+			"204": {Description: "The attachment was deleted."},
+			"400": errorResponse("Invalid attachment ID."),
+			"404": errorResponse("Item or attachment not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	priceChangeID := &Parameter{Name: "id", In: "path", Required: true, Description: "Price change ID.", Schema: &Schema{Type: "string", Format: "uuid"}}
+
+	doc.AddOperation(http.MethodGet, "/items/{name}/prices", &Operation{
+		OperationID: "listItemPrices",
+		Summary:     "List the price history of an item",
+		Description: "Every price the item had or is scheduled to have, latest effective_from first. Pending changes have no applied_at.",
+		Tags:        []string{"prices"},
+		Parameters:  []*Parameter{nameParam},
+		Responses: map[string]*Response{
+			"200": content("The price history.", mediaTypes, &Schema{Type: "array", Items: Ref("PriceChange")}),
+			"404": errorResponse("Item not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/items/{name}/prices", &Operation{
+		OperationID: "scheduleItemPrice",
+		Summary:     "Schedule a future price",
+		Description: "The price is applied to the item by the scheduler once effective_from has passed.",
+		Tags:        []string{"prices"},
+		Parameters:  []*Parameter{nameParam, idempotencyKey, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("PriceSchedule"))},
+		Responses: map[string]*Response{
+			"201": withHeaders(content("The scheduled price change.", mediaTypes, Ref("PriceChange")), map[string]*Header{
+				"Location": {Description: "URL of the price change.", Schema: &Schema{Type: "string"}},
+			}),
+			"400": errorResponse("Malformed request body."),
+			"404": errorResponse("Item not found."),
+			"422": errorResponse("The price is not positive or effective_from is not in the future."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/{name}/prices/{id}", &Operation{
+		OperationID: "getItemPrice",
+		Summary:     "Get a price change",
+		Tags:        []string{"prices"},
+		Parameters:  []*Parameter{nameParam, priceChangeID},
+		Responses: map[string]*Response{
+			"200": content("The price change.", mediaTypes, Ref("PriceChange")),
+			"400": errorResponse("Invalid price change ID."),
+			"404": errorResponse("Item or price change not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/items/{name}/prices/{id}", &Operation{
+		OperationID: "cancelItemPrice",
+		Summary:     "Cancel a scheduled price",
+		Tags:        []string{"prices"},
+		Parameters:  []*Parameter{nameParam, priceChangeID},
+		Responses: map[string]*Response{
+			"204": {Description: "The scheduled price was cancelled."},
+			"400": errorResponse("Invalid price change ID."),
+			"404": errorResponse("Item not found, or no pending price change with this ID."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	itemTypeName := &Parameter{Name: "name", In: "path", Required: true, Description: "Item type name; matched case-insensitively.", Schema: &Schema{Type: "string", MinLength: intPtr(1), MaxLength: intPtr(64)}}
+
+	doc.AddOperation(http.MethodGet, "/item-types", &Operation{
+		OperationID: "listItemTypes",
+		Summary:     "List item types",
+		Tags:        []string{"item-types"},
+		Responses: map[string]*Response{
+			"200": content("The item types.", mediaTypes, &Schema{Type: "array", Items: Ref("ItemType")}),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/item-types/{name}", &Operation{
+		OperationID: "getItemType",
+		Summary:     "Get an item type",
+		Tags:        []string{"item-types"},
+		Parameters:  []*Parameter{itemTypeName},
+		Responses: map[string]*Response{
+			"200": content("The item type.", mediaTypes, Ref("ItemType")),
+			"404": errorResponse("Item type not found."),
+			"422": errorResponse("Invalid item type name."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/item-types/{name}", &Operation{
+		OperationID: "putItemType",
+		Summary:     "Create or replace an item type",
+		Description: "Replacing the schema re-validates the attributes of every item of the type and is refused when any of them no longer matches.",
+		Tags:        []string{"item-types"},
+		Parameters:  []*Parameter{itemTypeName, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("ItemTypeInput"))},
+		Responses: map[string]*Response{
+			"200": content("The replaced item type.", mediaTypes, Ref("ItemType")),
+			"201": withHeaders(content("The created item type.", mediaTypes, Ref("ItemType")), map[string]*Header{
+				"Location": {Description: "URL of the item type.", Schema: &Schema{Type: "string"}},
+			}),
+			"400": errorResponse("Malformed request body."),
+			"409": errorResponse("Items of the type do not match the new schema."),
+			"422": errorResponse("Invalid item type name, or a schema using unsupported keywords."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/item-types/{name}", &Operation{
+		OperationID: "deleteItemType",
+		Summary:     "Delete an item type",
+		Tags:        []string{"item-types"},
+		Parameters:  []*Parameter{itemTypeName},
+		Responses: map[string]*Response{
+			"204": {Description: "The item type was deleted."},
+			"404": errorResponse("Item type not found."),
+			"409": errorResponse("Items still have the type."),
+			"422": errorResponse("Invalid item type name."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	currencyCode := &Parameter{Name: "currency", In: "path", Required: true, Description: "ISO 4217 currency code.", Schema: &Schema{Type: "string", MinLength: intPtr(3), MaxLength: intPtr(3)}}
+
+	doc.AddOperation(http.MethodGet, "/exchange-rates", &Operation{
+		OperationID: "listExchangeRates",
+		Summary:     "List exchange rates",
+		Description: "Units of each currency per one " + entities.BaseCurrency + ". Rates can also be loaded at startup from the JSON file named by EXCHANGE_RATES_FILE.",
+		Tags:        []string{"currencies"},
+		Responses: map[string]*Response{
+			"200": content("The exchange rates.", mediaTypes, &Schema{Type: "array", Items: Ref("ExchangeRate")}),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/exchange-rates/{currency}", &Operation{
+		OperationID: "setExchangeRate",
+		Summary:     "Create or replace an exchange rate",
+		Tags:        []string{"currencies"},
+		Parameters:  []*Parameter{currencyCode},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("ExchangeRateInput"))},
+		Responses: map[string]*Response{
+			"200": content("The exchange rate.", mediaTypes, Ref("ExchangeRate")),
+			"400": errorResponse("Malformed request body."),
+			"422": errorResponse("Invalid currency code, the base currency, or a rate that is not positive."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/exchange-rates/{currency}", &Operation{
+		OperationID: "deleteExchangeRate",
+		Summary:     "Delete an exchange rate",
+		Tags:        []string{"currencies"},
+		Parameters:  []*Parameter{currencyCode},
+		Responses: map[string]*Response{
+			"204": {Description: "The exchange rate was deleted."},
+			"404": errorResponse("No exchange rate for the currency."),
+			"422": errorResponse("Invalid currency code."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/{name}/currency-prices", &Operation{
+		OperationID: "listItemCurrencyPrices",
+		Summary:     "List the fixed prices of an item in other currencies",
+		Tags:        []string{"currencies"},
+		Parameters:  []*Parameter{nameParam},
+		Responses: map[string]*Response{
+			"200": content("The fixed prices.", mediaTypes, &Schema{Type: "array", Items: Ref("CurrencyPrice")}),
+			"404": errorResponse("Item not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/items/{name}/currency-prices/{currency}", &Operation{
+		OperationID: "setItemCurrencyPrice",
+		Summary:     "Fix the price of an item in a currency",
+		Description: "A fixed price is returned as-is by ?currency= instead of a converted one. It is rounded to the minor units of the currency.",
+		Tags:        []string{"currencies"},
+		Parameters:  []*Parameter{nameParam, currencyCode},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("CurrencyPriceInput"))},
+		Responses: map[string]*Response{
+			"200": content("The fixed price.", mediaTypes, Ref("CurrencyPrice")),
+			"400": errorResponse("Malformed request body."),
+			"404": errorResponse("Item not found."),
+			"422": errorResponse("Invalid currency code, or a price that is not positive after rounding."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/items/{name}/currency-prices/{currency}", &Operation{
+		OperationID: "deleteItemCurrencyPrice",
+		Summary:     "Remove the fixed price of an item in a currency",
+		Tags:        []string{"currencies"},
+		Parameters:  []*Parameter{nameParam, currencyCode},
+		Responses: map[string]*Response{
+			"204": {Description: "The fixed price was removed; the item is converted again."},
+			"404": errorResponse("Item not found, or it has no fixed price in the currency."),
+			"422": errorResponse("Invalid currency code."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	reservationID := &Parameter{Name: "id", In: "path", Required: true, Description: "Reservation ID.", Schema: &Schema{Type: "string", Format: "uuid"}}
+
+	doc.AddOperation(http.MethodGet, "/items/{name}/stock", &Operation{
+		OperationID: "getItemStock",
+		Summary:     "Get the stock level of an item",
+		Description: "Available is on_hand minus the quantity held by unexpired reservations.",
+		Tags:        []string{"stock"},
+		Parameters:  []*Parameter{nameParam},
+		Responses: map[string]*Response{
+			"200": content("The stock level.", mediaTypes, Ref("Stock")),
+			"404": errorResponse("Item not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/items/{name}/stock/receive", &Operation{
+		OperationID: "receiveStock",
+		Summary:     "Add received units to the stock",
+		Tags:        []string{"stock"},
+		Parameters:  []*Parameter{nameParam, idempotencyKey, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("StockAdjustment"))},
+		Responses: map[string]*Response{
+			"200": content("The stock level after the movement.", mediaTypes, Ref("Stock")),
+			"400": errorResponse("Malformed request body."),
+			"404": errorResponse("Item not found."),
+			"422": errorResponse("Quantity must be positive."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/items/{name}/stock/ship", &Operation{
+		OperationID: "shipStock",
+		Summary:     "Remove shipped units from the stock",
+		Description: "Only available units can be shipped; reserved units are shipped by committing their reservation.",
+		Tags:        []string{"stock"},
+		Parameters:  []*Parameter{nameParam, idempotencyKey, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("StockAdjustment"))},
+		Responses: map[string]*Response{
+			"200": content("The stock level after the movement.", mediaTypes, Ref("Stock")),
+			"400": errorResponse("Malformed request body."),
+			"404": errorResponse("Item not found."),
+			"409": errorResponse("Not enough available stock."),
+			"422": errorResponse("Quantity must be positive."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/items/{name}/stock/correct", &Operation{
+		OperationID: "correctStock",
+		Summary:     "Set the counted on-hand quantity",
+		Description: "Records the difference to the previous quantity as a correction movement.",
+		Tags:        []string{"stock"},
+		Parameters:  []*Parameter{nameParam, idempotencyKey, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("StockCorrection"))},
+		Responses: map[string]*Response{
+			"200": content("The corrected stock level.", mediaTypes, Ref("Stock")),
+			"400": errorResponse("Malformed request body."),
+			"404": errorResponse("Item not found."),
+			"409": errorResponse("The new quantity is below the reserved quantity."),
+			"422": errorResponse("The quantity is negative or the reason is missing."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/items/{name}/stock/movements", &Operation{
+		OperationID: "listStockMovements",
+		Summary:     "List the stock movements of an item",
+		Tags:        []string{"stock"},
+		Parameters: []*Parameter{
+			nameParam,
+			{Name: "limit", In: "query", Description: "Maximum number of movements to return.", Schema: &Schema{Type: "integer", Minimum: float64Ptr(1), Maximum: float64Ptr(500)}},
+		},
+		Responses: map[string]*Response{
+			"200": content("Movements, newest first.", mediaTypes, &Schema{Type: "array", Items: Ref("StockMovement")}),
+			"400": errorResponse("Invalid limit."),
+			"404": errorResponse("Item not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/items/{name}/reservations", &Operation{
+		OperationID: "reserveStock",
+		Summary:     "Reserve available units",
+		Description: "The reservation holds the units until it is committed, released or expires.",
+		Tags:        []string{"stock"},
+		Parameters:  []*Parameter{nameParam, idempotencyKey, actor},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("ReservationInput"))},
+		Responses: map[string]*Response{
+			"201": withHeaders(content("The reservation.", mediaTypes, Ref("Reservation")), map[string]*Header{
+				"Location": {Description: "URL of the reservation.", Schema: &Schema{Type: "string"}},
+			}),
+			"400": errorResponse("Malformed request body."),
+			"404": errorResponse("Item not found."),
+			"409": errorResponse("Not enough available stock, or the item is not active."),
+			"422": errorResponse("Invalid quantity or time to live."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/reservations/{id}", &Operation{
+		OperationID: "getReservation",
+		Summary:     "Get an active reservation",
+		Tags:        []string{"stock"},
+		Parameters:  []*Parameter{reservationID},
+		Responses: map[string]*Response{
+			"200": content("The reservation.", mediaTypes, Ref("Reservation")),
+			"400": errorResponse("Invalid reservation ID."),
+			"404": errorResponse("Reservation not found or expired."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/reservations/{id}", &Operation{
+		OperationID: "releaseReservation",
+		Summary:     "Release a reservation",
+		Tags:        []string{"stock"},
+		Parameters:  []*Parameter{reservationID},
+		Responses: map[string]*Response{
+			"204": {Description: "The reserved units are available again."},
+			"400": errorResponse("Invalid reservation ID."),
+			"404": errorResponse("Reservation not found or expired."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/reservations/{id}/commit", &Operation{
+		OperationID: "commitReservation",
+		Summary:     "Ship the reserved units",
+		Tags:        []string{"stock"},
+		Parameters:  []*Parameter{reservationID, idempotencyKey, actor},
+		Responses: map[string]*Response{
+			"200": content("The stock level after the shipment.", mediaTypes, Ref("Stock")),
+			"400": errorResponse("Invalid reservation ID."),
+			"404": errorResponse("Reservation not found or expired."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	webhookID := &Parameter{Name: "id", In: "path", Required: true, Description: "Webhook ID.", Schema: &Schema{Type: "string", Format: "uuid"}}
+	deliveryID := &Parameter{Name: "id", In: "path", Required: true, Description: "Delivery ID.", Schema: &Schema{Type: "string", Format: "uuid"}}
+	limit := &Parameter{Name: "limit", In: "query", Description: "Maximum number of deliveries to return.", Schema: &Schema{Type: "integer", Minimum: float64Ptr(1), Maximum: float64Ptr(500)}}
+
+	doc.AddOperation(http.MethodGet, "/webhooks", &Operation{
+		OperationID: "listWebhooks",
+		Summary:     "List webhook subscriptions",
+		Tags:        []string{"webhooks"},
+		Responses: map[string]*Response{
+			"200": content("Webhook subscriptions, without their secrets.", mediaTypes, &Schema{Type: "array", Items: Ref("Webhook")}),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/webhooks", &Operation{
+		OperationID: "createWebhook",
+		Summary:     "Subscribe a URL to item change events",
+		Description: "Deliveries are signed with HMAC-SHA256 over \"<X-Webhook-Timestamp>.<body>\" and sent in X-Webhook-Signature as sha256=<hex>. " +
+			"The secret is only returned by this call; one is generated when omitted.",
+		Tags:        []string{"webhooks"},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("WebhookInput"))},
+		Responses: map[string]*Response{
+			"201": withHeaders(content("The created webhook, including its secret.", mediaTypes, Ref("Webhook")), map[string]*Header{
+				"Location": {Description: "URL of the created webhook.", Schema: &Schema{Type: "string"}},
+			}),
+			"400": errorResponse("Malformed request body."),
+			"415": errorResponse("Unsupported request content type."),
+			"422": errorResponse("Body does not match the schema."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/webhooks/dead-letters", &Operation{
+		OperationID: "listDeadLetters",
+		Summary:     "List deliveries that exhausted their retries",
+		Tags:        []string{"webhooks"},
+		Parameters:  []*Parameter{limit},
+		Responses: map[string]*Response{
+			"200": content("Dead-lettered deliveries, most recent first.", mediaTypes, &Schema{Type: "array", Items: Ref("WebhookDelivery")}),
+			"400": errorResponse("Invalid parameters."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/webhooks/dead-letters/{id}/retry", &Operation{
+		OperationID: "retryDelivery",
+		Summary:     "Queue a dead-lettered delivery for another round of attempts",
+		Tags:        []string{"webhooks"},
+		Parameters:  []*Parameter{deliveryID},
+		Responses: map[string]*Response{
+			"202": content("The requeued delivery.", mediaTypes, Ref("WebhookDelivery")),
+			"400": errorResponse("Invalid delivery ID."),
+			"404": errorResponse("No dead-lettered delivery with this ID."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/webhooks/{id}", &Operation{
+		OperationID: "getWebhook",
+		Summary:     "Get a webhook subscription",
+		Tags:        []string{"webhooks"},
+		Parameters:  []*Parameter{webhookID},
+		Responses: map[string]*Response{
+			"200": content("The webhook, without its secret.", mediaTypes, Ref("Webhook")),
+			"400": errorResponse("Invalid webhook ID."),
+			"404": errorResponse("Webhook not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodPut, "/webhooks/{id}", &Operation{
+		OperationID: "updateWebhook",
+		Summary:     "Replace a webhook subscription",
+		Description: "The stored secret is kept when no secret is sent.",
+		Tags:        []string{"webhooks"},
+		Parameters:  []*Parameter{webhookID},
+		RequestBody: &RequestBody{Required: true, Content: mediaContent(mediaTypes, Ref("WebhookInput"))},
+		Responses: map[string]*Response{
+			"200": content("The updated webhook, without its secret.", mediaTypes, Ref("Webhook")),
+			"400": errorResponse("Malformed request body or invalid webhook ID."),
+			"404": errorResponse("Webhook not found."),
+			"415": errorResponse("Unsupported request content type."),
+			"422": errorResponse("Body does not match the schema."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+	doc.AddOperation(http.MethodDelete, "/webhooks/{id}", &Operation{
+		OperationID: "deleteWebhook",
+		Summary:     "Delete a webhook subscription and its delivery log",
+		Tags:        []string{"webhooks"},
+		Parameters:  []*Parameter{webhookID},
+		Responses: map[string]*Response{
+			"204": {Description: "The webhook was deleted."},
+			"400": errorResponse("Invalid webhook ID."),
+			"404": errorResponse("Webhook not found."),
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/webhooks/{id}/deliveries", &Operation{
+		OperationID: "listWebhookDeliveries",
+		Summary:     "Delivery log of a webhook",
+		Tags:        []string{"webhooks"},
+		Parameters:  []*Parameter{webhookID, limit},
+		Responses: map[string]*Response{
+			"200": content("Deliveries, most recent first.", mediaTypes, &Schema{Type: "array", Items: Ref("WebhookDelivery")}),
+			"400": errorResponse("Invalid parameters."),
+			"404": errorResponse("Webhook not found."),
+			"500": errorResponse("Unexpected error."),
+		},
+	})
+
+	graphQLBody := &Schema{
+		Description: "A GraphQL request, or an array of up to 10 requests to run as a batch.",
+		Properties: map[string]*Schema{
+			"query":         {Type: "string"},
+			"operationName": {Type: "string"},
+			"variables":     {Type: "object"},
+		},
+		Required: []string{"query"},
+	}
+	graphQLResult := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"data":   {Type: "object"},
+			"errors": {Type: "array", Items: &Schema{Type: "object"}},
+		},
+	}
+	doc.AddOperation(http.MethodGet, "/ws", &Operation{
+		OperationID: "subscribeItemsWebSocket",
+		Summary:     "Open a WebSocket that delivers change events for subscribed item names or IDs",
+		Description: `Send {"action":"subscribe"|"unsubscribe","names":[...],"ids":[...]} to change the subscription set. ` +
+			`The server answers with "subscribed", "unsubscribed" or "error" messages and pushes {"type":"event","event":{...}} for matching changes. ` +
+			"Slow consumers are disconnected with close code 1013.",
+		Tags: []string{"events"},
+		Responses: map[string]*Response{
+			"101": {Description: "Switching to the WebSocket protocol."},
+			"400": {Description: "Not a WebSocket handshake."},
+			"503": {Description: "The server is at its connection limit."},
+		},
+	})
+	doc.AddOperation(http.MethodGet, "/graphql", &Operation{
+		OperationID: "graphqlQuery",
+		Summary:     "Run a GraphQL query, or open GraphiQL when requested as text/html",
+		Tags:        []string{"graphql"},
+		Parameters: []*Parameter{
+			{Name: "query", In: "query", Schema: &Schema{Type: "string"}},
+			{Name: "operationName", In: "query", Schema: &Schema{Type: "string"}},
+			{Name: "variables", In: "query", Description: "JSON-encoded variables.", Schema: &Schema{Type: "string"}},
+		},
+		Responses: map[string]*Response{
+			"200": {
+				Description: "Query result or the GraphiQL page.",
+				Content: map[string]*MediaType{
+					"application/json": {Schema: graphQLResult},
+					"text/html":        {Schema: &Schema{Type: "string"}},
+				},
+			},
+		},
+	})
+	doc.AddOperation(http.MethodPost, "/graphql", &Operation{
+		OperationID: "graphqlExecute",
+		Summary:     "Execute a GraphQL operation or a batch of operations",
+		Tags:        []string{"graphql"},
+		RequestBody: &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				"application/json": {Schema: graphQLBody},
+			},
+		},
+		Responses: map[string]*Response{
+			"200": {Description: "Operation result, or an array of results for a batch.", Content: map[string]*MediaType{"application/json": {Schema: graphQLResult}}},
+			"400": {Description: "Malformed request.", Content: map[string]*MediaType{"application/json": {Schema: graphQLResult}}},
+		},
+	})
+
+	return doc
+}
+
+func (doc *Document) AddOperation(method string, path string, op *Operation) {
+	item, ok := doc.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		doc.Paths[path] = item
+	}
+	item.setOperation(method, op)
+}
+
+func (doc *Document) HasOperation(method string, path string) bool {
+	item, ok := doc.Paths[path]
+	if !ok {
+		return false
+	}
+	return item.Operation(method) != nil
+}
+
+func schemas() map[string]*Schema {
+	item := SchemaOf(entities.Item{})
+	item.Properties["id"].ReadOnly = true
+	item.Properties["created_at"].ReadOnly = true
+	item.Properties["created_by"].ReadOnly = true
+	item.Properties["updated_at"].ReadOnly = true
+	item.Properties["updated_by"].ReadOnly = true
+	item.Properties["tags"].ReadOnly = true
+	item.Properties["display_name"].ReadOnly = true
+	item.Properties["display_name"].Description = "Translated name, present when a translation was selected through Accept-Language. The name stays the identifier of the item."
+	item.Properties["tags"].Description = "Managed through /items/{name}/tags/{tag}."
+	item.Properties["name"].MinLength = intPtr(1)
+	item.Properties["price"].ExclusiveMinimum = float64Ptr(0)
+	item.Properties["description"].MinLength = intPtr(1)
+	item.Properties["currency"].MinLength = intPtr(3)
+	item.Properties["currency"].MaxLength = intPtr(3)
+	item.Properties["currency"].Description = "ISO 4217 code of the price. Defaults to " + entities.BaseCurrency + "."
+	item.Properties["status"].Enum = statuses()
+	item.Properties["status"].Description = statusDescription
+	item.Properties["type"].MaxLength = intPtr(64)
+	item.Properties["type"].Description = "Item type from /item-types. On update, omit it to keep the current type."
+	item.Properties["attributes"] = &Schema{Type: "object", Description: "Custom attributes validated against the schema of the item type. On update, omit them to keep the current attributes."}
+	item.Properties["variants"] = &Schema{Type: "array", Items: Ref("Variant"), ReadOnly: true, Description: "Managed through /items/{name}/variants."}
+
+	input := &Schema{
+		Type:                 "object",
+		Properties:           map[string]*Schema{},
+		AdditionalProperties: boolPtr(false),
+	}
+	for name, prop := range item.Properties {
+		if prop.ReadOnly {
+			continue
+		}
+		input.Properties[name] = prop
+	}
+	input.Required = slices.DeleteFunc(sortedKeys(input.Properties), func(name string) bool {
+		return name == "currency" || name == "status" || name == "type" || name == "attributes"
+	})
+
+	itemType := SchemaOf(entities.ItemType{})
+	itemType.Properties["name"].ReadOnly = true
+	itemType.Properties["created_at"].ReadOnly = true
+	itemType.Properties["created_by"].ReadOnly = true
+	itemType.Properties["updated_at"].ReadOnly = true
+	itemType.Properties["updated_by"].ReadOnly = true
+	itemType.Properties["schema"] = &Schema{Type: "object", Description: itemTypeSchemaDescription}
+
+	itemTypeInput := SchemaOf(entities.ItemTypeInput{})
+	itemTypeInput.AdditionalProperties = boolPtr(false)
+	itemTypeInput.Required = []string{"schema"}
+	itemTypeInput.Properties["description"].MaxLength = intPtr(1000)
+	itemTypeInput.Properties["schema"] = &Schema{Type: "object", Description: itemTypeSchemaDescription}
+
+	cat := SchemaOf(category.Category{})
+	cat.Properties["id"].ReadOnly = true
+	cat.Properties["created_at"].ReadOnly = true
+
+	catInput := SchemaOf(category.Input{})
+	catInput.AdditionalProperties = boolPtr(false)
+	catInput.Properties["name"].MinLength = intPtr(1)
+	catInput.Properties["name"].MaxLength = intPtr(category.MaxNameLength)
+	catInput.Properties["parent_id"].Description = "Parent category. Omit for a root category."
+
+	priceChange := SchemaOf(entities.PriceChange{})
+	priceChange.Properties["applied_at"].Description = "When the price was applied to the item. Absent while the change is pending."
+
+	priceSchedule := SchemaOf(entities.PriceSchedule{})
+	priceSchedule.AdditionalProperties = boolPtr(false)
+	priceSchedule.Properties["price"].ExclusiveMinimum = float64Ptr(0)
+
+	translationInput := SchemaOf(entities.TranslationInput{})
+	translationInput.AdditionalProperties = boolPtr(false)
+	translationInput.Properties["name"].MinLength = intPtr(1)
+	translationInput.Properties["description"].MinLength = intPtr(1)
+
+	variant := SchemaOf(entities.Variant{})
+	variant.Properties["price"].Description = "Price override in the currency of the item. Absent when the variant sells at the item price."
+	variant.Properties["effective_price"].Description = "The override when set, otherwise the price of the item."
+
+	variantInput := SchemaOf(entities.VariantInput{})
+	variantInput.AdditionalProperties = boolPtr(false)
+	variantInput.Properties["options"].Description = fmt.Sprintf("Between 1 and %d option dimensions such as size and colour. Every variant of an item uses the same option names, and no two share the same values.", entities.MaxVariantOptions)
+	variantInput.Properties["options"].Items.AdditionalProperties = boolPtr(false)
+	variantInput.Properties["options"].Items.Properties["name"].MinLength = intPtr(1)
+	variantInput.Properties["options"].Items.Properties["name"].MaxLength = intPtr(32)
+	variantInput.Properties["options"].Items.Properties["value"].MinLength = intPtr(1)
+	variantInput.Properties["options"].Items.Properties["value"].MaxLength = intPtr(entities.MaxVariantOptionLength)
+	variantInput.Properties["price"].ExclusiveMinimum = float64Ptr(0)
+	variantInput.Properties["price"].Description = "Price override in the currency of the item. Omit it to sell at the item price."
+
+	rateInput := SchemaOf(entities.ExchangeRateInput{})
+	rateInput.AdditionalProperties = boolPtr(false)
+	rateInput.Properties["rate"].ExclusiveMinimum = float64Ptr(0)
+	rateInput.Properties["rate"].Description = "Units of the currency per one " + entities.BaseCurrency + "."
+
+	currencyPriceInput := SchemaOf(entities.CurrencyPriceInput{})
+	currencyPriceInput.AdditionalProperties = boolPtr(false)
+	currencyPriceInput.Properties["price"].ExclusiveMinimum = float64Ptr(0)
+
+	adjustment := SchemaOf(entities.StockAdjustment{})
+	adjustment.AdditionalProperties = boolPtr(false)
+	adjustment.Properties["quantity"].Minimum = float64Ptr(1)
+	adjustment.Properties["reason"].MaxLength = intPtr(entities.MaxReasonLength)
+
+	correction := SchemaOf(entities.StockCorrection{})
+	correction.AdditionalProperties = boolPtr(false)
+	correction.Properties["on_hand"].Minimum = float64Ptr(0)
+	correction.Properties["reason"].MinLength = intPtr(1)
+	correction.Properties["reason"].MaxLength = intPtr(entities.MaxReasonLength)
+
+	reservationInput := SchemaOf(entities.ReservationInput{})
+	reservationInput.AdditionalProperties = boolPtr(false)
+	reservationInput.Properties["quantity"].Minimum = float64Ptr(1)
+	reservationInput.Properties["ttl_seconds"].Minimum = float64Ptr(1)
+	reservationInput.Properties["ttl_seconds"].Maximum = float64Ptr(entities.MaxReservationTTL.Seconds())
+	reservationInput.Properties["ttl_seconds"].Description = "Seconds the units are held. Defaults to 900 (15 minutes)."
+
+	hook := SchemaOf(webhook.Webhook{})
+	hook.Properties["id"].ReadOnly = true
+	hook.Properties["created_at"].ReadOnly = true
+	hook.Properties["events"].Items.Enum = eventTypes()
+
+	hookInput := SchemaOf(webhook.Input{})
+	hookInput.AdditionalProperties = boolPtr(false)
+	hookInput.Properties["url"].Format = "uri"
+	hookInput.Properties["url"].MinLength = intPtr(1)
+	hookInput.Properties["events"].Items.Enum = eventTypes()
+	hookInput.Properties["events"].Description = "Event types to receive. Empty means every event."
+	hookInput.Properties["secret"].MinLength = intPtr(webhook.MinSecretLength)
+
+	return map[string]*Schema{
+		"Category":           cat,
+		"CategoryInput":      catInput,
+		"Webhook":            hook,
+		"WebhookInput":       hookInput,
+		"WebhookDelivery":    SchemaOf(webhook.Delivery{}),
+		"Item":               item,
+		"ItemInput":          input,
+		"ItemType":           itemType,
+		"ItemTypeInput":      itemTypeInput,
+		"TagCount":           SchemaOf(entities.TagCount{}),
+		"Attachment":         SchemaOf(entities.Attachment{}),
+		"Translation":        SchemaOf(entities.Translation{}),
+		"TranslationInput":   translationInput,
+		"Variant":            variant,
+		"VariantInput":       variantInput,
+		"ExchangeRate":       SchemaOf(entities.ExchangeRate{}),
+		"ExchangeRateInput":  rateInput,
+		"CurrencyPrice":      SchemaOf(entities.CurrencyPrice{}),
+		"CurrencyPriceInput": currencyPriceInput,
+		"Stock":              SchemaOf(entities.Stock{}),
+		"StockMovement":      SchemaOf(entities.StockMovement{}),
+		"StockAdjustment":    adjustment,
+		"StockCorrection":    correction,
+		"Reservation":        SchemaOf(entities.Reservation{}),
+		"ReservationInput":   reservationInput,
+		"Error":              SchemaOf(representation.ErrorResponse{}),
+		"RowError":           SchemaOf(transfer.RowError{}),
+		"ItemEvent":          SchemaOf(events.Event{}),
+		"ImportResult": {
+			Type: "object",
+			Properties: map[string]*Schema{
+				"imported": {Type: "integer"},
+				"errors":   {Type: "array", Items: Ref("RowError")},
+			},
+		},
+	}
+}
+
+const acceptLanguageDescription = "Preferred languages. Each tag falls back to its parent (pt-BR, then pt) before the next tag, and finally to " +
+	entities.DefaultLocale + ". Content-Language lists the locales used in the response."
+
+const itemTypeSchemaDescription = "JSON Schema of the attributes, limited to type, properties, required, additionalProperties, items, " +
+	"minItems, maxItems, enum, minLength, maxLength, pattern, minimum, maximum, exclusiveMinimum, exclusiveMaximum, title, description " +
+	"and $schema. The root must be an object schema."
+
+const attributeFilterDescription = "Filter on custom attributes with attr.<name>=<value> for equality, or attr.<name>.gt, .gte, .lt " +
+	"and .lte for numeric comparisons. Repeat parameters to combine filters; all of them must match."
+
+const statusDescription = "Lifecycle status. New items are active unless created as draft. Afterwards the status only changes through " +
+	"POST /items/{name}/publish (draft or discontinued to active), unpublish (active to draft), discontinue (active to discontinued), " +
+	"archive (draft or discontinued to archived) and restore (archived to draft). Only active items can be reserved."
+
+const statusParamDescription = "Only items in these statuses, comma separated or repeated. Defaults to active; use all for every status."
+
+const currencyParamDescription = "Return prices in this ISO 4217 currency. A fixed price set through /items/{name}/currency-prices wins; " +
+	"otherwise the price is converted through the exchange rates and rounded half away from zero to the minor units of the currency " +
+	"(0 for JPY, 3 for KWD, 2 for most). Price filters and sorting use the stored prices. Disables conditional requests."
+
+func statuses() []any {
+	values := make([]any, len(entities.Statuses))
+	for i, status := range entities.Statuses {
+		values[i] = status
+	}
+	return values
+}
+
+func sortKeys() []any {
+	keys := make([]any, 0, 2*len(entities.SortKeys))
+	for _, key := range entities.SortKeys {
+		keys = append(keys, key, "-"+key)
+	}
+	return keys
+}
+
+func eventTypes() []any {
+	types := make([]any, 0, len(webhook.EventTypes))
+	for _, eventType := range webhook.EventTypes {
+		types = append(types, eventType)
+	}
+	return types
+}
+
+func content(description string, mediaTypes []string, schema *Schema) *Response {
+	return &Response{Description: description, Content: mediaContent(mediaTypes, schema)}
+}
+
+func errorResponse(description string) *Response {
+	return &Response{Description: description, Content: map[string]*MediaType{"application/json": {Schema: Ref("Error")}}}
+}
+
+func withHeaders(resp *Response, headers map[string]*Header) *Response {
+	resp.Headers = headers
+	return resp
+}
+
+func cacheHeaders() map[string]*Header {
+	return map[string]*Header{
+		"ETag":          {Description: "Validator for If-None-Match; differs per representation.", Schema: &Schema{Type: "string"}},
+		"Last-Modified": {Description: "Time of the last change, for If-Modified-Since.", Schema: &Schema{Type: "string"}},
+		"Cache-Control": {Description: "Caching policy configured for the route.", Schema: &Schema{Type: "string"}},
+	}
+}
+
+func attachmentContent(description string) *Response {
+	content := make(map[string]*MediaType, len(entities.AttachmentTypes))
+	for _, mediaType := range entities.AttachmentTypes {
+		content[mediaType] = &MediaType{Schema: &Schema{Type: "string", Format: "binary"}}
+	}
+	return &Response{Description: description, Content: content}
+}
+
+func attachmentHeaders() map[string]*Header {
+	return map[string]*Header{
+		"Content-Disposition": {Description: "Carries the original filename.", Schema: &Schema{Type: "string"}},
+		"Accept-Ranges":       {Description: "Always bytes.", Schema: &Schema{Type: "string"}},
+		"ETag":                {Description: "SHA-256 checksum of the content.", Schema: &Schema{Type: "string"}},
+	}
+}
+
+func localizedHeaders() map[string]*Header {
+	headers := cacheHeaders()
+	headers["Content-Language"] = &Header{Description: "Locales of the names and descriptions in the response.", Schema: &Schema{Type: "string"}}
+	return headers
+}
+
+func mediaContent(mediaTypes []string, schema *Schema) map[string]*MediaType {
+	result := make(map[string]*MediaType, len(mediaTypes))
+	for _, mt := range mediaTypes {
+		result[mt] = &MediaType{Schema: schema}
+	}
+	return result
+}
+
+func intPtr(v int) *int             { return &v }
+func float64Ptr(v float64) *float64 { return &v }
+func boolPtr(v bool) *bool          { return &v }
@@ -0,0 +1,12 @@
+package openapi
+
+import (
+	_ "embed"
+
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+//go:embed swagger.html
+var SwaggerUI []byte
+
+var SwaggerAssets = swaggerFiles.FS
@@ -0,0 +1,203 @@
+package openapi
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func (doc *Document) Resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		schema = doc.Components.Schemas[name]
+	}
+	return schema
+}
+
+func (doc *Document) Validate(schema *Schema, value any, in string, pointer string) []representation.ErrorDetail {
+	schema = doc.Resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	fail := func(format string, args ...any) []representation.ErrorDetail {
+		return []representation.ErrorDetail{{In: in, Pointer: pointer, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	if len(schema.Enum) > 0 {
+		matched := false
+		for _, allowed := range schema.Enum {
+			if fmt.Sprint(allowed) == fmt.Sprint(value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fail("must be one of %v", schema.Enum)
+		}
+	}
+
+	switch schema.Type {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fail("must be an object")
+		}
+		var errs []representation.ErrorDetail
+		for _, name := range schema.Required {
+			if _, present := obj[name]; !present {
+				errs = append(errs, representation.ErrorDetail{In: in, Pointer: pointer + "/" + escapePointer(name), Message: "is required"})
+			}
+		}
+		names := make([]string, 0, len(obj))
+		for name := range obj {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			child := pointer + "/" + escapePointer(name)
+			prop, known := schema.Properties[name]
+			if !known {
+				if schema.AdditionalProperties != nil && !*schema.AdditionalProperties {
+					errs = append(errs, representation.ErrorDetail{In: in, Pointer: child, Message: "is not a known field"})
+				}
+				continue
+			}
+			if doc.Resolve(prop).ReadOnly {
+				errs = append(errs, representation.ErrorDetail{In: in, Pointer: child, Message: "is read-only"})
+				continue
+			}
+			errs = append(errs, doc.Validate(prop, obj[name], in, child)...)
+		}
+		return errs
+
+	case "array":
+		arr, ok := value.([]any)
+		if !ok {
+			return fail("must be an array")
+		}
+		var errs []representation.ErrorDetail
+		for i, elem := range arr {
+			errs = append(errs, doc.Validate(schema.Items, elem, in, pointer+"/"+strconv.Itoa(i))...)
+		}
+		return errs
+
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fail("must be a string")
+		}
+		length := utf8.RuneCountInString(str)
+		if schema.MinLength != nil && length < *schema.MinLength {
+			if *schema.MinLength == 1 {
+				return fail("must not be empty")
+			}
+			return fail("must be at least %d characters", *schema.MinLength)
+		}
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			return fail("must be at most %d characters", *schema.MaxLength)
+		}
+		switch schema.Format {
+		case "uuid":
+			if !uuidPattern.MatchString(str) {
+				return fail("must be a UUID")
+			}
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339, str); err != nil {
+				return fail("must be an RFC 3339 timestamp")
+			}
+		}
+		return nil
+
+	case "number", "integer":
+		num, ok := toFloat(value)
+		if !ok {
+			return fail("must be a %s", schema.Type)
+		}
+		if schema.Type == "integer" && num != float64(int64(num)) {
+			return fail("must be an integer")
+		}
+		if schema.Minimum != nil && num < *schema.Minimum {
+			return fail("must be greater than or equal to %v", *schema.Minimum)
+		}
+		if schema.ExclusiveMinimum != nil && num <= *schema.ExclusiveMinimum {
+			return fail("must be greater than %v", *schema.ExclusiveMinimum)
+		}
+		if schema.Maximum != nil && num > *schema.Maximum {
+			return fail("must be less than or equal to %v", *schema.Maximum)
+		}
+		return nil
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fail("must be a boolean")
+		}
+		return nil
+	}
+
+	return nil
+}
+
+func (doc *Document) ValidateParameter(param *Parameter, raw string) []representation.ErrorDetail {
+	schema := doc.Resolve(param.Schema)
+	if schema == nil {
+		return nil
+	}
+
+	var value any = raw
+	switch schema.Type {
+	case "integer", "number":
+		num, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return []representation.ErrorDetail{{In: param.In, Pointer: "/" + escapePointer(param.Name), Message: "must be a " + schema.Type}}
+		}
+		value = num
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return []representation.ErrorDetail{{In: param.In, Pointer: "/" + escapePointer(param.Name), Message: "must be a boolean"}}
+		}
+		value = b
+	}
+	return doc.Validate(schema, value, param.In, "/"+escapePointer(param.Name))
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
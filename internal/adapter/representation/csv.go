@@ -0,0 +1,96 @@
+package representation
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/transfer"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/google/uuid"
+)
+
+type CSV struct{}
+
+func (CSV) MediaType() string {
+	return "text/csv"
+}
+
+func (CSV) Encode(w io.Writer, v any) error {
+	var items []*entities.Item
+	switch value := v.(type) {
+	case *entities.Item:
+		items = []*entities.Item{value}
+	case []*entities.Item:
+		items = value
+	default:
+		return ErrUnsupportedValue
+	}
+
+	writer := transfer.CSV.NewWriter(w)
+	for _, item := range items {
+		if err := writer.Write(item); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+func (CSV) Decode(r io.Reader, v any) error {
+	item, ok := v.(*entities.Item)
+	if !ok {
+		return ErrUnsupportedValue
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read csv header: %v", err)
+	}
+	record, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read csv row: %v", err)
+	}
+
+	for i, column := range header {
+		if i >= len(record) {
+			break
+		}
+		value := strings.TrimSpace(record[i])
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "id":
+			if value != "" {
+				if item.ID, err = uuid.Parse(value); err != nil {
+					return fmt.Errorf("invalid id '%s'", value)
+				}
+			}
+		case "name":
+			item.Name = value
+		case "price":
+			if item.Price, err = strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("invalid price '%s'", value)
+			}
+		case "currency":
+			item.Currency = value
+		case "description":
+			item.Description = value
+		case "status":
+			item.Status = value
+		case "type":
+			item.Type = value
+		case "attributes":
+			if value != "" {
+				if !json.Valid([]byte(value)) {
+					return fmt.Errorf("invalid attributes '%s'", value)
+				}
+				item.Attributes = json.RawMessage(value)
+			}
+		}
+	}
+	return nil
+}
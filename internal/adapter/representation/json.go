@@ -0,0 +1,20 @@
+package representation
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type JSON struct{}
+
+func (JSON) MediaType() string {
+	return "application/json"
+}
+
+func (JSON) Encode(w io.Writer, v any) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+func (JSON) Decode(r io.Reader, v any) error {
+	return json.NewDecoder(r).Decode(v)
+}
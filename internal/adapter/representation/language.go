@@ -0,0 +1,44 @@
+package representation
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func PreferredLanguages(header string) []string {
+	type languageRange struct {
+		tag string
+		q   float64
+	}
+
+	var ranges []languageRange
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || !strings.EqualFold(strings.TrimSpace(key), "q") {
+				continue
+			}
+			if parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+				q = parsed
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		ranges = append(ranges, languageRange{tag: tag, q: q})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	tags := make([]string, len(ranges))
+	for i, lr := range ranges {
+		tags[i] = lr.tag
+	}
+	return tags
+}
@@ -0,0 +1,25 @@
+package representation
+
+import (
+	"io"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type MessagePack struct{}
+
+func (MessagePack) MediaType() string {
+	return "application/msgpack"
+}
+
+func (MessagePack) Encode(w io.Writer, v any) error {
+	enc := msgpack.NewEncoder(w)
+	enc.SetCustomStructTag("json")
+	return enc.Encode(v)
+}
+
+func (MessagePack) Decode(r io.Reader, v any) error {
+	dec := msgpack.NewDecoder(r)
+	dec.SetCustomStructTag("json")
+	return dec.Decode(v)
+}
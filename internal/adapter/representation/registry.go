@@ -0,0 +1,182 @@
+package representation
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNotAcceptable        = errors.New("none of the requested representations is supported")
+	ErrUnsupportedMediaType = errors.New("request content type is not supported")
+	ErrUnsupportedValue     = errors.New("value cannot be represented in this format")
+)
+
+type Codec interface {
+	MediaType() string
+	Encode(w io.Writer, v any) error
+	Decode(r io.Reader, v any) error
+}
+
+type Registry struct {
+	Language string
+	codecs   []Codec
+}
+
+func NewRegistry(codecs ...Codec) *Registry {
+	return &Registry{codecs: codecs}
+}
+
+func NewDefaultRegistry() *Registry {
+	return NewRegistry(JSON{}, XML{}, MessagePack{}, CSV{})
+}
+
+func (reg *Registry) Register(codec Codec) {
+	for i, c := range reg.codecs {
+		if c.MediaType() == codec.MediaType() {
+			reg.codecs[i] = codec
+			return
+		}
+	}
+	reg.codecs = append(reg.codecs, codec)
+}
+
+func (reg *Registry) Default() Codec {
+	return reg.codecs[0]
+}
+
+func (reg *Registry) MediaTypes() []string {
+	types := make([]string, len(reg.codecs))
+	for i, c := range reg.codecs {
+		types[i] = c.MediaType()
+	}
+	return types
+}
+
+func (reg *Registry) Lookup(mediaType string) (Codec, bool) {
+	for _, c := range reg.codecs {
+		if c.MediaType() == mediaType {
+			return c, true
+		}
+	}
+	for _, alias := range aliases[mediaType] {
+		for _, c := range reg.codecs {
+			if c.MediaType() == alias {
+				return c, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (reg *Registry) ForContentType(contentType string) (Codec, error) {
+	if strings.TrimSpace(contentType) == "" {
+		return reg.Default(), nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, ErrUnsupportedMediaType
+	}
+	if c, ok := reg.Lookup(mediaType); ok {
+		return c, nil
+	}
+	return nil, ErrUnsupportedMediaType
+}
+
+func (reg *Registry) Negotiate(accept string) (Codec, error) {
+	if strings.TrimSpace(accept) == "" {
+		return reg.Default(), nil
+	}
+
+	ranges := parseAccept(accept)
+	for _, ar := range ranges {
+		if ar.q == 0 {
+			continue
+		}
+		switch {
+		case ar.mediaType == "*/*":
+			if c := reg.firstAllowed(ranges, func(Codec) bool { return true }); c != nil {
+				return c, nil
+			}
+		case strings.HasSuffix(ar.mediaType, "/*"):
+			prefix := strings.TrimSuffix(ar.mediaType, "*")
+			if c := reg.firstAllowed(ranges, func(c Codec) bool { return strings.HasPrefix(c.MediaType(), prefix) }); c != nil {
+				return c, nil
+			}
+		default:
+			if c, ok := reg.Lookup(ar.mediaType); ok {
+				return c, nil
+			}
+		}
+	}
+	return nil, ErrNotAcceptable
+}
+
+func (reg *Registry) firstAllowed(ranges []acceptRange, match func(Codec) bool) Codec {
+	for _, c := range reg.codecs {
+		if !match(c) {
+			continue
+		}
+		excluded := false
+		for _, ar := range ranges {
+			if ar.q == 0 && ar.mediaType == c.MediaType() {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			return c
+		}
+	}
+	return nil
+}
+
+var aliases = map[string][]string{
+	"text/xml":                {"application/xml"},
+	"application/x-msgpack":   {"application/msgpack"},
+	"application/vnd.msgpack": {"application/msgpack"},
+}
+
+type acceptRange struct {
+	mediaType string
+	q         float64
+	index     int
+}
+
+func parseAccept(accept string) []acceptRange {
+	var ranges []acceptRange
+	for i, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		ranges = append(ranges, acceptRange{mediaType: mediaType, q: q, index: i})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		if ranges[i].q != ranges[j].q {
+			return ranges[i].q > ranges[j].q
+		}
+		return specificity(ranges[i].mediaType) > specificity(ranges[j].mediaType)
+	})
+	return ranges
+}
+
+func specificity(mediaType string) int {
+	switch {
+	case mediaType == "*/*":
+		return 0
+	case strings.HasSuffix(mediaType, "/*"):
+		return 1
+	default:
+		return 2
+	}
+}
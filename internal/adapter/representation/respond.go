@@ -0,0 +1,92 @@
+package representation
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type ErrorResponse struct {
+	XMLName xml.Name      `json:"-" xml:"error"`
+	Error   string        `json:"error" xml:"message"`
+	Details []ErrorDetail `json:"details,omitempty" xml:"details>detail,omitempty"`
+}
+
+type ErrorDetail struct {
+	In      string `json:"in" xml:"in,attr"`
+	Pointer string `json:"pointer" xml:"pointer,attr"`
+	Message string `json:"message" xml:",chardata"`
+}
+
+func (reg *Registry) Respond(w http.ResponseWriter, r *http.Request, status int, v any) {
+	AddVary(w.Header(), "Accept")
+
+	codec, err := reg.Negotiate(r.Header.Get("Accept"))
+	if err != nil {
+		reg.write(w, reg.Default(), http.StatusNotAcceptable, ErrorResponse{Error: err.Error()})
+		return
+	}
+	reg.write(w, codec, status, v)
+}
+
+func AddVary(h http.Header, field string) {
+	for _, value := range h.Values("Vary") {
+		for _, existing := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(existing), field) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", field)
+}
+
+func (reg *Registry) Error(w http.ResponseWriter, r *http.Request, status int, message string) {
+	reg.Respond(w, r, status, ErrorResponse{Error: message})
+}
+
+func (reg *Registry) ErrorWithDetails(w http.ResponseWriter, r *http.Request, status int, message string, details []ErrorDetail) {
+	reg.Respond(w, r, status, ErrorResponse{Error: message, Details: details})
+}
+
+func (reg *Registry) Bind(r *http.Request, v any) error {
+	codec, err := reg.ForContentType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return err
+	}
+	return codec.Decode(r.Body, v)
+}
+
+func (reg *Registry) BindError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, ErrUnsupportedMediaType) || errors.Is(err, ErrUnsupportedValue) {
+		reg.Error(w, r, http.StatusUnsupportedMediaType, err.Error())
+		return
+	}
+	reg.Error(w, r, http.StatusBadRequest, err.Error())
+}
+
+func (reg *Registry) write(w http.ResponseWriter, codec Codec, status int, v any) {
+	var buf bytes.Buffer
+	err := codec.Encode(&buf, v)
+	if errors.Is(err, ErrUnsupportedValue) {
+		buf.Reset()
+		codec = reg.Default()
+		err = codec.Encode(&buf, v)
+	}
+	if err != nil {
+		log.Printf("Failed to encode %s response: %v", codec.MediaType(), err)
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", codec.MediaType())
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	if reg.Language != "" && w.Header().Get("Content-Language") == "" {
+		w.Header().Set("Content-Language", reg.Language)
+	}
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,358 @@
+package representation
+
+import (
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	categoryEntities "github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	webhookEntities "github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+)
+
+type XML struct{}
+
+var xmlNames = map[reflect.Type]string{
+	reflect.TypeOf(entities.StockMovement{}):   "movement",
+	reflect.TypeOf(entities.StockAdjustment{}): "adjustment",
+	reflect.TypeOf(entities.StockCorrection{}): "correction",
+	reflect.TypeOf(entities.TagCount{}):        "tag",
+	reflect.TypeOf(entities.VariantOption{}):   "option",
+	reflect.TypeOf(categoryEntities.Input{}):   "category",
+	reflect.TypeOf(webhookEntities.Input{}):    "webhook",
+}
+
+var (
+	xmlMarshalerType    = reflect.TypeOf((*xml.Marshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	rawMessageType      = reflect.TypeOf(json.RawMessage{})
+)
+
+func (XML) MediaType() string {
+	return "application/xml"
+}
+
+func (XML) Encode(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice {
+		list := xml.StartElement{Name: xml.Name{Local: "list"}}
+		if err := enc.EncodeToken(list); err != nil {
+			return err
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if err := encodeXMLValue(enc, xmlElementName(rv.Index(i).Type()), rv.Index(i)); err != nil {
+				return err
+			}
+		}
+		if err := enc.EncodeToken(list.End()); err != nil {
+			return err
+		}
+		return enc.Flush()
+	}
+
+	if err := encodeXMLValue(enc, xmlElementName(rv.Type()), rv); err != nil {
+		return err
+	}
+	return enc.Flush()
+}
+
+func (XML) Decode(r io.Reader, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("xml: cannot decode into %T", v)
+	}
+	if describesXML(rv.Type()) {
+		return xml.NewDecoder(r).Decode(v)
+	}
+
+	var root xmlNode
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return err
+	}
+	value, err := root.value(rv.Type().Elem())
+	if err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, v)
+}
+
+func describesXML(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Implements(xmlMarshalerType) || reflect.PointerTo(t).Implements(xmlMarshalerType) {
+		return true
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup("xml"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func xmlElementName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface {
+		if t.Kind() == reflect.Interface {
+			return "value"
+		}
+		t = t.Elem()
+	}
+	if name, ok := xmlNames[t]; ok {
+		return name
+	}
+	name := strings.TrimSuffix(t.Name(), "Input")
+	if name == "" {
+		return "value"
+	}
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+type xmlField struct {
+	index     int
+	name      string
+	omitEmpty bool
+}
+
+func xmlFields(t reflect.Type) []xmlField {
+	var fields []xmlField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, xmlField{index: i, name: name, omitEmpty: strings.Contains(","+opts+",", ",omitempty,")})
+	}
+	return fields
+}
+
+func encodeXMLValue(enc *xml.Encoder, name string, v reflect.Value) error {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+
+	if describesXML(v.Type()) {
+		return enc.Encode(v.Interface())
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return err
+		}
+		return enc.EncodeElement(string(text), start)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if err := enc.EncodeToken(start); err != nil {
+			return err
+		}
+		for _, f := range xmlFields(v.Type()) {
+			field := v.Field(f.index)
+			if f.omitEmpty && isEmptyXMLValue(field) {
+				continue
+			}
+			if err := encodeXMLValue(enc, f.name, field); err != nil {
+				return err
+			}
+		}
+		return enc.EncodeToken(start.End())
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return enc.EncodeElement(string(v.Bytes()), start)
+		}
+		if err := enc.EncodeToken(start); err != nil {
+			return err
+		}
+		item := xmlItemName(name, v.Type().Elem())
+		for i := 0; i < v.Len(); i++ {
+			if err := encodeXMLValue(enc, item, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return enc.EncodeToken(start.End())
+	case reflect.Map:
+		if err := enc.EncodeToken(start); err != nil {
+			return err
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, key := range keys {
+			if err := encodeXMLValue(enc, fmt.Sprint(key), v.MapIndex(key)); err != nil {
+				return err
+			}
+		}
+		return enc.EncodeToken(start.End())
+	default:
+		return enc.EncodeElement(v.Interface(), start)
+	}
+}
+
+func isEmptyXMLValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.Struct:
+		return false
+	default:
+		return v.IsZero()
+	}
+}
+
+func xmlItemName(name string, elem reflect.Type) string {
+	for elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	if elem.Kind() == reflect.Struct && !elem.Implements(textMarshalerType) {
+		return xmlElementName(elem)
+	}
+	if singular := strings.TrimSuffix(name, "s"); singular != "" {
+		return singular
+	}
+	return name
+}
+
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Text     string     `xml:",chardata"`
+	Children []xmlNode  `xml:",any"`
+}
+
+func (n xmlNode) value(t reflect.Type) (any, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	text := strings.TrimSpace(n.Text)
+
+	if t == rawMessageType {
+		if json.Valid([]byte(text)) {
+			return json.RawMessage(text), nil
+		}
+		return text, nil
+	}
+	if t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return text, nil
+	}
+	if t.Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return json.RawMessage(text), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		fields := map[string]reflect.Type{}
+		for _, f := range xmlFields(t) {
+			fields[f.name] = t.Field(f.index).Type
+		}
+		object := map[string]any{}
+		for _, attr := range n.Attrs {
+			n.Children = append(n.Children, xmlNode{XMLName: attr.Name, Text: attr.Value})
+		}
+		for _, child := range n.Children {
+			ft, ok := fields[child.XMLName.Local]
+			if !ok {
+				object[child.XMLName.Local] = strings.TrimSpace(child.Text)
+				continue
+			}
+			value, err := child.value(ft)
+			if err != nil {
+				return nil, err
+			}
+			object[child.XMLName.Local] = value
+		}
+		return object, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return text, nil
+		}
+		list := make([]any, 0, len(n.Children))
+		for _, child := range n.Children {
+			value, err := child.value(t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		return list, nil
+	case reflect.Map:
+		object := map[string]any{}
+		for _, child := range n.Children {
+			value, err := child.value(t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			object[child.XMLName.Local] = value
+		}
+		return object, nil
+	case reflect.Bool:
+		if text == "" {
+			return nil, nil
+		}
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, fmt.Errorf("xml: invalid boolean '%s' in <%s>", text, n.XMLName.Local)
+		}
+		return b, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if text == "" {
+			return nil, nil
+		}
+		if _, err := strconv.ParseFloat(text, 64); err != nil {
+			return nil, fmt.Errorf("xml: invalid number '%s' in <%s>", text, n.XMLName.Local)
+		}
+		return json.Number(text), nil
+	case reflect.Interface:
+		if len(n.Children) > 0 {
+			return n.value(reflect.TypeOf(map[string]any{}))
+		}
+		return text, nil
+	default:
+		return n.Text, nil
+	}
+}
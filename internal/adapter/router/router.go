@@ -6,16 +6,130 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
-func NewRouter(itemController *controller.ItemController) *chi.Mux {
+type Controllers struct {
+	Items       *controller.ItemController
+	Events      *controller.EventsController
+	WebSocket   *controller.WebSocketController
+	GraphQL     *controller.GraphQLController
+	Webhooks    *controller.WebhookController
+	Categories  *controller.CategoryController
+	Stock       *controller.StockController
+	Currencies  *controller.CurrencyController
+	Attachments *controller.AttachmentController
+	ItemTypes   *controller.ItemTypeController
+	Docs        *controller.DocsController
+}
+
+type Middlewares struct {
+	RequestValidation *middlewares.RequestValidation
+	CacheControl      *middlewares.CacheControl
+	Idempotency       *middlewares.Idempotency
+}
+
+func NewRouter(controllers Controllers, mw Middlewares) *chi.Mux {
 	r := chi.NewRouter()
 
 	r.Use(middlewares.Logging)
 
-	r.Get("/items", itemController.GetItems)
-	r.Get("/items/{name}", itemController.GetItemByName)
-	r.Post("/items", itemController.CreateItem)
-	r.Put("/items/{name}", itemController.UpdateItem)
-	r.Delete("/items/{name}", itemController.DeleteItem)
+	itemController := controllers.Items
+	docsController := controllers.Docs
+	webhookController := controllers.Webhooks
+	categoryController := controllers.Categories
+	stockController := controllers.Stock
+	currencyController := controllers.Currencies
+	attachmentController := controllers.Attachments
+	itemTypeController := controllers.ItemTypes
+
+	r.Group(func(r chi.Router) {
+		r.Use(mw.RequestValidation.Handler)
+		r.Use(mw.CacheControl.Handler)
+		idempotent := r.With(mw.Idempotency.Handler)
+
+		r.Get("/items", itemController.GetItems)
+		r.Get("/items/export", itemController.ExportItems)
+		r.Get("/items/events", controllers.Events.StreamItemEvents)
+		idempotent.Post("/items/import", itemController.ImportItems)
+		r.Get("/items/{name}", itemController.GetItemByName)
+		idempotent.Post("/items", itemController.CreateItem)
+		r.Put("/items/{name}", itemController.UpdateItem)
+		r.Delete("/items/{name}", itemController.DeleteItem)
+		idempotent.Post("/items/{name}/publish", itemController.PublishItem)
+		idempotent.Post("/items/{name}/unpublish", itemController.UnpublishItem)
+		idempotent.Post("/items/{name}/discontinue", itemController.DiscontinueItem)
+		idempotent.Post("/items/{name}/archive", itemController.ArchiveItem)
+		idempotent.Post("/items/{name}/restore", itemController.RestoreItem)
+		r.Put("/items/{name}/tags/{tag}", itemController.AddTag)
+		r.Delete("/items/{name}/tags/{tag}", itemController.RemoveTag)
+		r.Get("/items/{name}/prices", itemController.ListPriceChanges)
+		idempotent.Post("/items/{name}/prices", itemController.SchedulePriceChange)
+		r.Get("/items/{name}/prices/{id}", itemController.GetPriceChange)
+		r.Delete("/items/{name}/prices/{id}", itemController.CancelPriceChange)
+		r.Get("/items/{name}/translations", itemController.ListTranslations)
+		r.Get("/items/{name}/translations/{locale}", itemController.GetTranslation)
+		r.Put("/items/{name}/translations/{locale}", itemController.SetTranslation)
+		r.Delete("/items/{name}/translations/{locale}", itemController.DeleteTranslation)
+		r.Get("/items/{name}/variants", itemController.ListVariants)
+		r.Get("/items/{name}/variants/{sku}", itemController.GetVariant)
+		r.Put("/items/{name}/variants/{sku}", itemController.PutVariant)
+		r.Delete("/items/{name}/variants/{sku}", itemController.DeleteVariant)
+		r.Get("/tags", itemController.ListTags)
+
+		r.Get("/categories", categoryController.ListCategories)
+		r.Post("/categories", categoryController.CreateCategory)
+		r.Get("/categories/{id}", categoryController.GetCategory)
+		r.Put("/categories/{id}", categoryController.UpdateCategory)
+		r.Delete("/categories/{id}", categoryController.DeleteCategory)
+		r.Get("/categories/{id}/items", categoryController.ListItems)
+		r.Put("/categories/{id}/items/{name}", categoryController.AddItem)
+		r.Delete("/categories/{id}/items/{name}", categoryController.RemoveItem)
+
+		r.Get("/items/{name}/stock", stockController.GetStock)
+		idempotent.Post("/items/{name}/stock/receive", stockController.Receive)
+		idempotent.Post("/items/{name}/stock/ship", stockController.Ship)
+		idempotent.Post("/items/{name}/stock/correct", stockController.Correct)
+		r.Get("/items/{name}/stock/movements", stockController.ListMovements)
+		idempotent.Post("/items/{name}/reservations", stockController.Reserve)
+		r.Get("/reservations/{id}", stockController.GetReservation)
+		r.Delete("/reservations/{id}", stockController.ReleaseReservation)
+		idempotent.Post("/reservations/{id}/commit", stockController.CommitReservation)
+
+		r.Get("/exchange-rates", currencyController.ListRates)
+		r.Put("/exchange-rates/{currency}", currencyController.SetRate)
+		r.Delete("/exchange-rates/{currency}", currencyController.DeleteRate)
+		r.Get("/items/{name}/currency-prices", currencyController.ListItemPrices)
+		r.Put("/items/{name}/currency-prices/{currency}", currencyController.SetItemPrice)
+		r.Delete("/items/{name}/currency-prices/{currency}", currencyController.DeleteItemPrice)
+
+		r.Get("/items/{name}/attachments", attachmentController.ListAttachments)
+		r.Post("/items/{name}/attachments", attachmentController.UploadAttachment)
+		r.Get("/items/{name}/attachments/{id}", attachmentController.GetAttachment)
+		r.Get("/items/{name}/attachments/{id}/content", attachmentController.DownloadAttachment)
+		r.Delete("/items/{name}/attachments/{id}", attachmentController.DeleteAttachment)
+
+		r.Get("/item-types", itemTypeController.ListItemTypes)
+		r.Get("/item-types/{name}", itemTypeController.GetItemType)
+		r.Put("/item-types/{name}", itemTypeController.PutItemType)
+		r.Delete("/item-types/{name}", itemTypeController.DeleteItemType)
+
+		r.Get("/webhooks", webhookController.ListWebhooks)
+		r.Post("/webhooks", webhookController.CreateWebhook)
+		r.Get("/webhooks/dead-letters", webhookController.ListDeadLetters)
+		r.Post("/webhooks/dead-letters/{id}/retry", webhookController.RetryDelivery)
+		r.Get("/webhooks/{id}", webhookController.GetWebhook)
+		r.Put("/webhooks/{id}", webhookController.UpdateWebhook)
+		r.Delete("/webhooks/{id}", webhookController.DeleteWebhook)
+		r.Get("/webhooks/{id}/deliveries", webhookController.ListDeliveries)
+	})
+
+	r.Get("/ws", controllers.WebSocket.Subscribe)
+
+	r.Get("/graphql", controllers.GraphQL.Query)
+	r.Post("/graphql", controllers.GraphQL.Query)
+	r.Get("/graphql/assets/*", controllers.GraphQL.GetGraphiQLAsset)
+
+	r.Get("/openapi.json", docsController.GetSpec)
+	r.Get("/docs", docsController.GetSwaggerUI)
+	r.Get("/docs/assets/*", docsController.GetSwaggerAsset)
 
 	return r
 }
@@ -0,0 +1,128 @@
+package transfer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+var csvHeader = []string{"id", "name", "price", "currency", "description", "status", "type", "attributes"}
+
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVWriter(w io.Writer) ItemWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (cw *csvWriter) Write(item *entities.Item) error {
+	if !cw.wroteHeader {
+		if err := cw.w.Write(csvHeader); err != nil {
+			return err
+		}
+		cw.wroteHeader = true
+	}
+	return cw.w.Write([]string{
+		item.ID.String(),
+		item.Name,
+		strconv.FormatFloat(item.Price, 'f', -1, 64),
+		item.Currency,
+		item.Description,
+		item.Status,
+		item.Type,
+		string(item.Attributes),
+	})
+}
+
+func (cw *csvWriter) Flush() error {
+	if !cw.wroteHeader {
+		if err := cw.w.Write(csvHeader); err != nil {
+			return err
+		}
+		cw.wroteHeader = true
+	}
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func readCSV(r io.Reader) ([]*entities.Item, []RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, errors.New("csv header is required")
+		}
+		return nil, nil, fmt.Errorf("failed to read csv header: %v", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"name", "price", "description"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("csv header is missing column '%s'", required)
+		}
+	}
+
+	var items []*entities.Item
+	var rowErrors []RowError
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rowErrors = append(rowErrors, RowError{Line: parseErr.Line, Error: parseErr.Err.Error()})
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to read csv row: %v", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		price, err := strconv.ParseFloat(field("price"), 64)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Error: fmt.Sprintf("invalid price '%s'", field("price"))})
+			continue
+		}
+
+		item, err := entities.NewItem(field("name"), price, field("description"))
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Error: err.Error()})
+			continue
+		}
+		item.Currency, err = entities.NormalizeCurrency(field("currency"))
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Error: err.Error()})
+			continue
+		}
+		if err := applyOptionalFields(item, field("status"), field("type"), []byte(field("attributes"))); err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Error: err.Error()})
+			continue
+		}
+		items = append(items, item)
+	}
+
+	return items, rowErrors, nil
+}
@@ -0,0 +1,97 @@
+package transfer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"strings"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type RowError struct {
+	Line  int    `json:"line" xml:"line"`
+	Error string `json:"error" xml:"message"`
+}
+
+type ItemWriter interface {
+	Write(item *entities.Item) error
+	Flush() error
+}
+
+type Format struct {
+	ContentType string
+	Extension   string
+	NewWriter   func(w io.Writer) ItemWriter
+	ReadItems   func(r io.Reader) ([]*entities.Item, []RowError, error)
+}
+
+var CSV = Format{
+	ContentType: "text/csv",
+	Extension:   "csv",
+	NewWriter:   newCSVWriter,
+	ReadItems:   readCSV,
+}
+
+var NDJSON = Format{
+	ContentType: "application/x-ndjson",
+	Extension:   "ndjson",
+	NewWriter:   newNDJSONWriter,
+	ReadItems:   readNDJSON,
+}
+
+var formats = []Format{CSV, NDJSON}
+
+func FormatForContentType(contentType string) (Format, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return Format{}, false
+	}
+	for _, f := range formats {
+		if f.ContentType == mediaType {
+			return f, true
+		}
+	}
+	return Format{}, false
+}
+
+func FormatForAccept(accept string) (Format, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return CSV, true
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if mediaType == "*/*" || mediaType == "text/*" {
+			return CSV, true
+		}
+		if f, ok := FormatForContentType(mediaType); ok {
+			return f, true
+		}
+	}
+	return Format{}, false
+}
+
+func applyOptionalFields(item *entities.Item, status string, itemType string, attributes []byte) error {
+	if status != "" {
+		normalized, err := entities.NormalizeStatus(status)
+		if err != nil {
+			return err
+		}
+		item.Status = normalized
+	}
+	item.Type = itemType
+	attributes = bytes.TrimSpace(attributes)
+	if len(attributes) == 0 || bytes.Equal(attributes, []byte("null")) {
+		return nil
+	}
+	if attributes[0] != '{' || !json.Valid(attributes) {
+		return errors.New("attributes must be a JSON object")
+	}
+	item.Attributes = json.RawMessage(attributes)
+	return nil
+}
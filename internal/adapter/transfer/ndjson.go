@@ -0,0 +1,75 @@
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+const maxNDJSONLineSize = 1 << 20
+
+type ndjsonWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) ItemWriter {
+	bw := bufio.NewWriter(w)
+	return &ndjsonWriter{w: bw, enc: json.NewEncoder(bw)}
+}
+
+func (nw *ndjsonWriter) Write(item *entities.Item) error {
+	return nw.enc.Encode(item)
+}
+
+func (nw *ndjsonWriter) Flush() error {
+	return nw.w.Flush()
+}
+
+func readNDJSON(r io.Reader) ([]*entities.Item, []RowError, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLineSize)
+
+	var items []*entities.Item
+	var rowErrors []RowError
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		var row entities.Item
+		if err := json.Unmarshal(raw, &row); err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Error: fmt.Sprintf("invalid json: %v", err)})
+			continue
+		}
+
+		item, err := entities.NewItem(row.Name, row.Price, row.Description)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Error: err.Error()})
+			continue
+		}
+		item.Currency, err = entities.NormalizeCurrency(row.Currency)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Error: err.Error()})
+			continue
+		}
+		if err := applyOptionalFields(item, row.Status, row.Type, row.Attributes); err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Error: err.Error()})
+			continue
+		}
+		items = append(items, item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read ndjson line %d: %v", line+1, err)
+	}
+
+	return items, rowErrors, nil
+}
@@ -0,0 +1,52 @@
+package category
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const MaxNameLength = 100
+
+type Category struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type Input struct {
+	Name     string     `json:"name"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+}
+
+func NewCategory(input Input) (*Category, error) {
+	c := &Category{
+		ID:        uuid.New(),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := c.Apply(input); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Category) Apply(input Input) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return &ValidationError{Field: "name", Message: fmt.Sprintf("name must be at most %d characters", MaxNameLength)}
+	}
+	if input.ParentID != nil && *input.ParentID == c.ID {
+		return fmt.Errorf("category '%s' cannot be its own parent: %w", c.ID, ErrCategoryCycle)
+	}
+
+	c.Name = name
+	c.ParentID = input.ParentID
+	return nil
+}
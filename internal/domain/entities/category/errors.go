@@ -0,0 +1,25 @@
+package category
+
+import "errors"
+
+var (
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrCategoryAlreadyExists = errors.New("category already exists")
+	ErrCategoryNotEmpty      = errors.New("category is not empty")
+	ErrCategoryCycle         = errors.New("category hierarchy would contain a cycle")
+	ErrAssignmentNotFound    = errors.New("item is not assigned to category")
+	ErrInvalidCategory       = errors.New("invalid category")
+)
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidCategory
+}
@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const MaxAttachmentSize int64 = 10 << 20
+
+var AttachmentTypes = []string{"application/pdf", "image/gif", "image/jpeg", "image/png", "image/webp", "text/plain"}
+
+type Attachment struct {
+	ID          uuid.UUID `json:"id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"`
+	CreatedAt   time.Time `json:"created_at"`
+	CreatedBy   string    `json:"created_by"`
+}
+
+func NewAttachment(filename string, contentType string, actor string, at time.Time) (*Attachment, error) {
+	name := path.Base(strings.ReplaceAll(strings.TrimSpace(filename), `\`, "/"))
+	if name == "." || name == "/" || name == "" {
+		return nil, &ValidationError{Field: "filename", Message: "filename is required"}
+	}
+	if len(name) > 255 || strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return nil, &ValidationError{Field: "filename", Message: fmt.Sprintf("filename '%s' is not allowed", filename)}
+	}
+	if !AllowedAttachmentType(contentType) {
+		return nil, fmt.Errorf("%s: %w", contentType, ErrUnsupportedAttachment)
+	}
+	return &Attachment{
+		ID:          uuid.New(),
+		Filename:    name,
+		ContentType: contentType,
+		CreatedAt:   at,
+		CreatedBy:   actor,
+	}, nil
+}
+
+func AllowedAttachmentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range AttachmentTypes {
+		if mediaType == allowed {
+			return true
+		}
+	}
+	return false
+}
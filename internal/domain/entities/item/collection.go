@@ -0,0 +1,8 @@
+package entities
+
+import "time"
+
+type CollectionVersion struct {
+	Version   int64
+	UpdatedAt time.Time
+}
@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const BaseCurrency = "USD"
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+var minorUnits = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+type ExchangeRate struct {
+	Currency  string    `json:"currency"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ExchangeRateInput struct {
+	Rate float64 `json:"rate"`
+}
+
+type CurrencyPrice struct {
+	Currency string  `json:"currency"`
+	Price    float64 `json:"price"`
+}
+
+type CurrencyPriceInput struct {
+	Price float64 `json:"price"`
+}
+
+func NormalizeCurrency(code string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if normalized == "" {
+		return BaseCurrency, nil
+	}
+	if !currencyPattern.MatchString(normalized) {
+		return "", &ValidationError{Field: "currency", Message: fmt.Sprintf("currency '%s' must be a three-letter ISO 4217 code", code)}
+	}
+	return normalized, nil
+}
+
+func MinorUnits(currency string) int {
+	if units, ok := minorUnits[currency]; ok {
+		return units
+	}
+	return 2
+}
+
+func RoundPrice(amount float64, currency string) float64 {
+	factor := math.Pow10(MinorUnits(currency))
+	scaled, _ := strconv.ParseFloat(strconv.FormatFloat(amount*factor, 'f', 6, 64), 64)
+	return math.Round(scaled) / factor
+}
+
+func NewExchangeRate(currency string, input ExchangeRateInput, at time.Time) (*ExchangeRate, error) {
+	normalized, err := NormalizeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	if normalized == BaseCurrency {
+		return nil, &ValidationError{Field: "currency", Message: fmt.Sprintf("the rate of the base currency %s is always 1", BaseCurrency)}
+	}
+	if input.Rate <= 0 {
+		return nil, &ValidationError{Field: "rate", Message: "rate must be greater than 0"}
+	}
+	return &ExchangeRate{Currency: normalized, Rate: input.Rate, UpdatedAt: at}, nil
+}
+
+func (i *Item) ConvertTo(currency string, rates map[string]float64) error {
+	if i.Currency == currency {
+		return nil
+	}
+	from, ok := rateOf(i.Currency, rates)
+	if !ok {
+		return fmt.Errorf("no exchange rate for %s: %w", i.Currency, ErrExchangeRateNotFound)
+	}
+	to, ok := rateOf(currency, rates)
+	if !ok {
+		return fmt.Errorf("no exchange rate for %s: %w", currency, ErrExchangeRateNotFound)
+	}
+	i.Price = RoundPrice(i.Price/from*to, currency)
+	i.Currency = currency
+	return nil
+}
+
+func rateOf(currency string, rates map[string]float64) (float64, bool) {
+	if currency == BaseCurrency {
+		return 1, true
+	}
+	rate, ok := rates[currency]
+	return rate, ok
+}
+
+func NewCurrencyPrice(currency string, input CurrencyPriceInput) (*CurrencyPrice, error) {
+	normalized, err := NormalizeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	if input.Price <= 0 {
+		return nil, &ValidationError{Field: "price", Message: "price must be greater than 0"}
+	}
+	price := RoundPrice(input.Price, normalized)
+	if price <= 0 {
+		return nil, &ValidationError{Field: "price", Message: fmt.Sprintf("price rounds to 0 in %s", normalized)}
+	}
+	return &CurrencyPrice{Currency: normalized, Price: price}, nil
+}
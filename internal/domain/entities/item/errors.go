@@ -0,0 +1,38 @@
+package entities
+
+import "errors"
+
+var (
+	ErrItemNotFound          = errors.New("item not found")
+	ErrItemAlreadyExists     = errors.New("item already exists")
+	ErrInvalidItem           = errors.New("invalid item")
+	ErrTagNotFound           = errors.New("tag not found")
+	ErrInsufficientStock     = errors.New("insufficient stock")
+	ErrReservationNotFound   = errors.New("reservation not found")
+	ErrPriceChangeNotFound   = errors.New("price change not found")
+	ErrExchangeRateNotFound  = errors.New("exchange rate not found")
+	ErrCurrencyPriceNotFound = errors.New("currency price not found")
+	ErrTranslationNotFound   = errors.New("translation not found")
+	ErrAttachmentNotFound    = errors.New("attachment not found")
+	ErrAttachmentTooLarge    = errors.New("attachment is too large")
+	ErrUnsupportedAttachment = errors.New("unsupported attachment type")
+	ErrItemTypeNotFound      = errors.New("item type not found")
+	ErrItemTypeInUse         = errors.New("item type is in use")
+	ErrVariantNotFound       = errors.New("variant not found")
+	ErrVariantAlreadyExists  = errors.New("variant already exists")
+	ErrInvalidTransition     = errors.New("invalid status transition")
+	ErrItemNotSellable       = errors.New("item is not for sale")
+)
+
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidItem
+}
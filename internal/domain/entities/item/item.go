@@ -1,32 +1,68 @@
 package entities
 
 import (
-	"errors"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Item struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Price       float64   `json:"price"`
-	Description string    `json:"description"`
+	ID          uuid.UUID       `json:"id"`
+	Name        string          `json:"name"`
+	DisplayName string          `json:"display_name,omitempty"`
+	Price       float64         `json:"price"`
+	Currency    string          `json:"currency"`
+	Description string          `json:"description"`
+	Status      string          `json:"status"`
+	Type        string          `json:"type,omitempty"`
+	Attributes  json.RawMessage `json:"attributes,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	CreatedBy   string          `json:"created_by"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	UpdatedBy   string          `json:"updated_by"`
+	Tags        []string        `json:"tags,omitempty"`
+	Variants    []*Variant      `json:"variants,omitempty"`
 }
 
 func NewItem(name string, price float64, description string) (*Item, error) {
-	if name == "" {
-		return nil, errors.New("name is required")
-	}
-	if price <= 0 {
-		return nil, errors.New("price must be greater than 0")
-	}
-	if description == "" {
-		return nil, errors.New("description is required")
-	}
-	return &Item{
+	item := &Item{
 		ID:          uuid.New(),
 		Name:        name,
 		Price:       price,
+		Currency:    BaseCurrency,
 		Description: description,
-	}, nil
+		Status:      StatusActive,
+	}
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (i *Item) Validate() error {
+	if i.Name == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	if i.Price <= 0 {
+		return &ValidationError{Field: "price", Message: "price must be greater than 0"}
+	}
+	if i.Description == "" {
+		return &ValidationError{Field: "description", Message: "description is required"}
+	}
+	return nil
+}
+
+func (i *Item) MarkCreated(actor string, at time.Time) {
+	i.CreatedAt = at
+	i.CreatedBy = actor
+	i.UpdatedAt = at
+	i.UpdatedBy = actor
+}
+
+func (i *Item) MarkUpdated(actor string, at time.Time) {
+	i.CreatedAt = time.Time{}
+	i.CreatedBy = ""
+	i.UpdatedAt = at
+	i.UpdatedBy = actor
 }
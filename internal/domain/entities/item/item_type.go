@@ -0,0 +1,396 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	itemTypeNamePattern  = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,63}$`)
+	attributeNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+)
+
+var schemaTypes = map[string]bool{
+	"object":  true,
+	"array":   true,
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"boolean": true,
+}
+
+type ItemType struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Schema      json.RawMessage `json:"schema"`
+	CreatedAt   time.Time       `json:"created_at"`
+	CreatedBy   string          `json:"created_by"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	UpdatedBy   string          `json:"updated_by"`
+}
+
+type ItemTypeInput struct {
+	Description string          `json:"description"`
+	Schema      json.RawMessage `json:"schema"`
+}
+
+type AttributeSchema struct {
+	Dialect              string                      `json:"$schema,omitempty"`
+	Title                string                      `json:"title,omitempty"`
+	Description          string                      `json:"description,omitempty"`
+	Type                 string                      `json:"type"`
+	Properties           map[string]*AttributeSchema `json:"properties,omitempty"`
+	Required             []string                    `json:"required,omitempty"`
+	AdditionalProperties *bool                       `json:"additionalProperties,omitempty"`
+	Items                *AttributeSchema            `json:"items,omitempty"`
+	MinItems             *int                        `json:"minItems,omitempty"`
+	MaxItems             *int                        `json:"maxItems,omitempty"`
+	Enum                 []any                       `json:"enum,omitempty"`
+	MinLength            *int                        `json:"minLength,omitempty"`
+	MaxLength            *int                        `json:"maxLength,omitempty"`
+	Pattern              string                      `json:"pattern,omitempty"`
+	Minimum              *float64                    `json:"minimum,omitempty"`
+	Maximum              *float64                    `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64                    `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64                    `json:"exclusiveMaximum,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+func NormalizeItemTypeName(name string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if !itemTypeNamePattern.MatchString(normalized) {
+		return "", &ValidationError{Field: "type", Message: fmt.Sprintf("item type '%s' must start with a letter and contain only letters, digits, '-' or '_' (max 64)", name)}
+	}
+	return normalized, nil
+}
+
+func NewItemType(name string, input ItemTypeInput, actor string, at time.Time) (*ItemType, error) {
+	normalized, err := NormalizeItemTypeName(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.Description) > 1000 {
+		return nil, &ValidationError{Field: "description", Message: "description must be at most 1000 characters"}
+	}
+	if _, err := ParseAttributeSchema(input.Schema); err != nil {
+		return nil, err
+	}
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, input.Schema); err != nil {
+		return nil, &ValidationError{Field: "schema", Message: fmt.Sprintf("schema is not valid JSON: %v", err)}
+	}
+	return &ItemType{
+		Name:        normalized,
+		Description: input.Description,
+		Schema:      compacted.Bytes(),
+		CreatedAt:   at,
+		CreatedBy:   actor,
+		UpdatedAt:   at,
+		UpdatedBy:   actor,
+	}, nil
+}
+
+func ParseAttributeSchema(raw json.RawMessage) (*AttributeSchema, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil, &ValidationError{Field: "schema", Message: "schema is required"}
+	}
+	schema, err := decodeAttributeSchema(raw)
+	if err != nil {
+		return nil, err
+	}
+	if schema.Type != "object" {
+		return nil, &ValidationError{Field: "schema", Message: "schema must describe an object"}
+	}
+	for name := range schema.Properties {
+		if !attributeNamePattern.MatchString(name) {
+			return nil, &ValidationError{Field: "schema", Message: fmt.Sprintf("attribute name '%s' must start with a letter or '_' and contain only letters, digits or '_'", name)}
+		}
+	}
+	if err := schema.compile("#"); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func decodeAttributeSchema(raw json.RawMessage) (*AttributeSchema, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+	var schema AttributeSchema
+	if err := decoder.Decode(&schema); err != nil {
+		return nil, &ValidationError{Field: "schema", Message: fmt.Sprintf("schema is not supported: %v", err)}
+	}
+	if decoder.More() {
+		return nil, &ValidationError{Field: "schema", Message: "schema must be a single JSON object"}
+	}
+	return &schema, nil
+}
+
+func (s *AttributeSchema) compile(path string) error {
+	invalid := func(format string, args ...any) error {
+		return &ValidationError{Field: "schema", Message: path + ": " + fmt.Sprintf(format, args...)}
+	}
+	if !schemaTypes[s.Type] {
+		return invalid("type must be one of object, array, string, number, integer or boolean")
+	}
+	if len(s.Properties) > 0 || len(s.Required) > 0 || s.AdditionalProperties != nil {
+		if s.Type != "object" {
+			return invalid("properties, required and additionalProperties apply only to objects")
+		}
+	}
+	if s.Items != nil || s.MinItems != nil || s.MaxItems != nil {
+		if s.Type != "array" {
+			return invalid("items, minItems and maxItems apply only to arrays")
+		}
+	}
+	if s.Type == "array" && s.Items == nil {
+		return invalid("arrays must declare items")
+	}
+	if s.MinLength != nil || s.MaxLength != nil || s.Pattern != "" {
+		if s.Type != "string" {
+			return invalid("minLength, maxLength and pattern apply only to strings")
+		}
+	}
+	if s.Minimum != nil || s.Maximum != nil || s.ExclusiveMinimum != nil || s.ExclusiveMaximum != nil {
+		if s.Type != "number" && s.Type != "integer" {
+			return invalid("minimum, maximum, exclusiveMinimum and exclusiveMaximum apply only to numbers")
+		}
+	}
+	for _, bound := range []*int{s.MinItems, s.MaxItems, s.MinLength, s.MaxLength} {
+		if bound != nil && *bound < 0 {
+			return invalid("length bounds must not be negative")
+		}
+	}
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			return invalid("required attribute '%s' is not declared in properties", name)
+		}
+	}
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return invalid("pattern is not a valid regular expression: %v", err)
+		}
+		s.pattern = pattern
+	}
+	for i, value := range s.Enum {
+		normalized, err := normalizeNumbers(value)
+		if err != nil {
+			return invalid("enum: %v", err)
+		}
+		s.Enum[i] = normalized
+		if problem := s.check(normalized, ""); problem != "" {
+			return invalid("enum value %s does not satisfy the schema", formatValue(normalized))
+		}
+	}
+	for _, name := range sortedSchemaKeys(s.Properties) {
+		if s.Properties[name] == nil {
+			return invalid("property '%s' must be a schema object", name)
+		}
+		if err := s.Properties[name].compile(path + "/properties/" + name); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.compile(path + "/items")
+	}
+	return nil
+}
+
+func (t *ItemType) ValidateAttributes(attributes json.RawMessage) (json.RawMessage, error) {
+	schema, err := ParseAttributeSchema(t.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(attributes)) == 0 || string(bytes.TrimSpace(attributes)) == "null" {
+		attributes = json.RawMessage("{}")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(attributes))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return nil, &ValidationError{Field: "attributes", Message: "attributes must be a JSON object"}
+	}
+	value, err = normalizeNumbers(value)
+	if err != nil {
+		return nil, &ValidationError{Field: "attributes", Message: err.Error()}
+	}
+	if problems := schema.validate(value, ""); len(problems) > 0 {
+		return nil, &ValidationError{Field: "attributes", Message: fmt.Sprintf("attributes do not match item type '%s': %s", t.Name, strings.Join(problems, "; "))}
+	}
+	compacted, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode attributes: %v", err)
+	}
+	return compacted, nil
+}
+
+func (s *AttributeSchema) validate(value any, pointer string) []string {
+	if problem := s.check(value, pointer); problem != "" {
+		return []string{problem}
+	}
+	var problems []string
+	switch v := value.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				problems = append(problems, fmt.Sprintf("%s/%s is required", pointer, name))
+			}
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					problems = append(problems, fmt.Sprintf("%s/%s is not allowed", pointer, name))
+				}
+				continue
+			}
+			problems = append(problems, property.validate(v[name], pointer+"/"+name)...)
+		}
+	case []any:
+		for i, element := range v {
+			problems = append(problems, s.Items.validate(element, pointer+"/"+strconv.Itoa(i))...)
+		}
+	}
+	return problems
+}
+
+func (s *AttributeSchema) check(value any, pointer string) string {
+	location := pointer
+	if location == "" {
+		location = "/"
+	}
+	if !matchesType(s.Type, value) {
+		return fmt.Sprintf("%s must be %s", location, article(s.Type))
+	}
+	if len(s.Enum) > 0 {
+		found := false
+		for _, allowed := range s.Enum {
+			if reflect.DeepEqual(allowed, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("%s must be one of %s", location, formatValue(s.Enum))
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.MinLength != nil && length < *s.MinLength {
+			return fmt.Sprintf("%s must be at least %d characters", location, *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			return fmt.Sprintf("%s must be at most %d characters", location, *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			return fmt.Sprintf("%s must match pattern %s", location, s.Pattern)
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			return fmt.Sprintf("%s must be at least %v", location, *s.Minimum)
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			return fmt.Sprintf("%s must be at most %v", location, *s.Maximum)
+		}
+		if s.ExclusiveMinimum != nil && v <= *s.ExclusiveMinimum {
+			return fmt.Sprintf("%s must be greater than %v", location, *s.ExclusiveMinimum)
+		}
+		if s.ExclusiveMaximum != nil && v >= *s.ExclusiveMaximum {
+			return fmt.Sprintf("%s must be less than %v", location, *s.ExclusiveMaximum)
+		}
+	case []any:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			return fmt.Sprintf("%s must have at least %d items", location, *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			return fmt.Sprintf("%s must have at most %d items", location, *s.MaxItems)
+		}
+	}
+	return ""
+}
+
+func matchesType(schemaType string, value any) bool {
+	switch v := value.(type) {
+	case map[string]any:
+		return schemaType == "object"
+	case []any:
+		return schemaType == "array"
+	case string:
+		return schemaType == "string"
+	case bool:
+		return schemaType == "boolean"
+	case float64:
+		return schemaType == "number" || (schemaType == "integer" && v == math.Trunc(v))
+	}
+	return false
+}
+
+func normalizeNumbers(value any) (any, error) {
+	switch v := value.(type) {
+	case json.Number:
+		number, err := v.Float64()
+		if err != nil || math.IsInf(number, 0) {
+			return nil, fmt.Errorf("number %s is out of range", v)
+		}
+		return number, nil
+	case map[string]any:
+		for key, element := range v {
+			normalized, err := normalizeNumbers(element)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = normalized
+		}
+	case []any:
+		for i, element := range v {
+			normalized, err := normalizeNumbers(element)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = normalized
+		}
+	}
+	return value, nil
+}
+
+func article(schemaType string) string {
+	switch schemaType {
+	case "object", "array", "integer":
+		return "an " + schemaType
+	}
+	return "a " + schemaType
+}
+
+func formatValue(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
+
+func sortedSchemaKeys(m map[string]*AttributeSchema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
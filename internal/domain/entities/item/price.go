@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceChange struct {
+	ID            uuid.UUID  `json:"id"`
+	ItemID        uuid.UUID  `json:"item_id"`
+	Price         float64    `json:"price"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	AppliedAt     *time.Time `json:"applied_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CreatedBy     string     `json:"created_by"`
+}
+
+type PriceSchedule struct {
+	Price         float64   `json:"price"`
+	EffectiveFrom time.Time `json:"effective_from"`
+}
+
+func NewAppliedPriceChange(itemID uuid.UUID, price float64, actor string, at time.Time) *PriceChange {
+	return &PriceChange{
+		ID:            uuid.New(),
+		ItemID:        itemID,
+		Price:         price,
+		EffectiveFrom: at,
+		AppliedAt:     &at,
+		CreatedAt:     at,
+		CreatedBy:     actor,
+	}
+}
+
+func NewScheduledPriceChange(itemID uuid.UUID, schedule PriceSchedule, actor string, now time.Time) (*PriceChange, error) {
+	if schedule.Price <= 0 {
+		return nil, &ValidationError{Field: "price", Message: "price must be greater than 0"}
+	}
+	if !schedule.EffectiveFrom.After(now) {
+		return nil, &ValidationError{Field: "effective_from", Message: "effective_from must be in the future"}
+	}
+	return &PriceChange{
+		ID:            uuid.New(),
+		ItemID:        itemID,
+		Price:         schedule.Price,
+		EffectiveFrom: schedule.EffectiveFrom.UTC(),
+		CreatedAt:     now,
+		CreatedBy:     actor,
+	}, nil
+}
+
+func (c *PriceChange) Pending() bool {
+	return c.AppliedAt == nil
+}
@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SortByName      = "name"
+	SortByPrice     = "price"
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+)
+
+var SortKeys = []string{SortByName, SortByPrice, SortByCreatedAt, SortByUpdatedAt}
+
+const (
+	AttributeEquals         = "eq"
+	AttributeGreaterThan    = "gt"
+	AttributeGreaterOrEqual = "gte"
+	AttributeLessThan       = "lt"
+	AttributeLessOrEqual    = "lte"
+)
+
+var AttributeOperators = []string{AttributeEquals, AttributeGreaterThan, AttributeGreaterOrEqual, AttributeLessThan, AttributeLessOrEqual}
+
+type AttributeFilter struct {
+	Name     string
+	Operator string
+	Value    any
+	Raw      string
+}
+
+type ItemQuery struct {
+	NameContains  string
+	MinPrice      *float64
+	MaxPrice      *float64
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	CreatedBy     string
+	UpdatedBy     string
+	Tags          []string
+	MatchAnyTag   bool
+	Statuses      []string
+	Type          string
+	Attributes    []AttributeFilter
+	SortBy        string
+	Descending    bool
+	AfterName     string
+	Limit         int
+}
+
+func ParseSort(value string) (string, bool, error) {
+	if value == "" {
+		return SortByName, false, nil
+	}
+	key, descending := strings.CutPrefix(value, "-")
+	for _, known := range SortKeys {
+		if key == known {
+			return key, descending, nil
+		}
+	}
+	return "", false, &ValidationError{Field: "sort", Message: fmt.Sprintf("cannot sort by '%s'", key)}
+}
+
+func ParseAttributeFilter(key string, value string) (AttributeFilter, error) {
+	name, operator, hasOperator := strings.Cut(key, ".")
+	if !hasOperator {
+		operator = AttributeEquals
+	}
+	if !attributeNamePattern.MatchString(name) {
+		return AttributeFilter{}, &ValidationError{Field: "attr." + key, Message: fmt.Sprintf("cannot filter on attribute '%s'", name)}
+	}
+	known := false
+	for _, candidate := range AttributeOperators {
+		if operator == candidate {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return AttributeFilter{}, &ValidationError{Field: "attr." + key, Message: fmt.Sprintf("unknown attribute operator '%s'", operator)}
+	}
+
+	filter := AttributeFilter{Name: name, Operator: operator, Value: value, Raw: value}
+	if number, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(number) && !math.IsInf(number, 0) {
+		filter.Value = number
+	} else if operator != AttributeEquals {
+		return AttributeFilter{}, &ValidationError{Field: "attr." + key, Message: fmt.Sprintf("attribute '%s' can only be compared with a number", name)}
+	} else if value == "true" || value == "false" {
+		filter.Value = value == "true"
+	}
+	return filter, nil
+}
+
+func (f AttributeFilter) Matches(attributes map[string]any) bool {
+	actual, ok := attributes[f.Name]
+	if !ok {
+		return false
+	}
+	if f.Operator == AttributeEquals {
+		return actual == f.Value || actual == f.Raw
+	}
+	number, ok := actual.(float64)
+	if !ok {
+		return false
+	}
+	expected := f.Value.(float64)
+	switch f.Operator {
+	case AttributeGreaterThan:
+		return number > expected
+	case AttributeGreaterOrEqual:
+		return number >= expected
+	case AttributeLessThan:
+		return number < expected
+	default:
+		return number <= expected
+	}
+}
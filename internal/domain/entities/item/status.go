@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	StatusDraft        = "draft"
+	StatusActive       = "active"
+	StatusDiscontinued = "discontinued"
+	StatusArchived     = "archived"
+)
+
+var Statuses = []string{StatusDraft, StatusActive, StatusDiscontinued, StatusArchived}
+
+const AllStatuses = "all"
+
+const (
+	ActionPublish     = "publish"
+	ActionUnpublish   = "unpublish"
+	ActionDiscontinue = "discontinue"
+	ActionArchive     = "archive"
+	ActionRestore     = "restore"
+)
+
+type Transition struct {
+	Action string
+	From   []string
+	To     string
+}
+
+var Transitions = []Transition{
+	{Action: ActionPublish, From: []string{StatusDraft, StatusDiscontinued}, To: StatusActive},
+	{Action: ActionUnpublish, From: []string{StatusActive}, To: StatusDraft},
+	{Action: ActionDiscontinue, From: []string{StatusActive}, To: StatusDiscontinued},
+	{Action: ActionArchive, From: []string{StatusDraft, StatusDiscontinued}, To: StatusArchived},
+	{Action: ActionRestore, From: []string{StatusArchived}, To: StatusDraft},
+}
+
+func NormalizeStatus(status string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(status))
+	for _, known := range Statuses {
+		if normalized == known {
+			return normalized, nil
+		}
+	}
+	return "", &ValidationError{Field: "status", Message: fmt.Sprintf("status '%s' must be one of %s", status, strings.Join(Statuses, ", "))}
+}
+
+func ParseStatuses(values []string) ([]string, error) {
+	seen := map[string]bool{}
+	var statuses []string
+	for _, value := range values {
+		for _, raw := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(raw), AllStatuses) {
+				return append([]string(nil), Statuses...), nil
+			}
+			status, err := NormalizeStatus(raw)
+			if err != nil {
+				return nil, err
+			}
+			if !seen[status] {
+				seen[status] = true
+				statuses = append(statuses, status)
+			}
+		}
+	}
+	return statuses, nil
+}
+
+func (i *Item) NextStatus(action string) (string, error) {
+	for _, t := range Transitions {
+		if t.Action != action {
+			continue
+		}
+		for _, from := range t.From {
+			if i.Status == from {
+				return t.To, nil
+			}
+		}
+		return "", fmt.Errorf("cannot %s item '%s' while it is %s: %w", action, i.Name, i.Status, ErrInvalidTransition)
+	}
+	return "", fmt.Errorf("unknown transition '%s': %w", action, ErrInvalidTransition)
+}
+
+func (i *Item) Sellable() bool {
+	return i.Status == StatusActive
+}
@@ -0,0 +1,110 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MovementKind string
+
+const (
+	MovementReceive MovementKind = "receive"
+	MovementShip    MovementKind = "ship"
+	MovementCorrect MovementKind = "correct"
+)
+
+const (
+	DefaultReservationTTL = 15 * time.Minute
+	MaxReservationTTL     = 24 * time.Hour
+	MaxReasonLength       = 500
+)
+
+type Stock struct {
+	ItemID    uuid.UUID `json:"item_id"`
+	OnHand    int       `json:"on_hand"`
+	Reserved  int       `json:"reserved"`
+	Available int       `json:"available"`
+}
+
+type StockMovement struct {
+	ID            uuid.UUID    `json:"id"`
+	ItemID        uuid.UUID    `json:"item_id"`
+	Kind          MovementKind `json:"kind"`
+	Quantity      int          `json:"quantity"`
+	OnHandAfter   int          `json:"on_hand_after"`
+	Reason        string       `json:"reason"`
+	ReservationID *uuid.UUID   `json:"reservation_id,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+	CreatedBy     string       `json:"created_by"`
+}
+
+type StockAdjustment struct {
+	Quantity int    `json:"quantity"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+type StockCorrection struct {
+	OnHand int    `json:"on_hand"`
+	Reason string `json:"reason"`
+}
+
+type Reservation struct {
+	ID        uuid.UUID `json:"id"`
+	ItemID    uuid.UUID `json:"item_id"`
+	Quantity  int       `json:"quantity"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+}
+
+type ReservationInput struct {
+	Quantity   int `json:"quantity"`
+	TTLSeconds int `json:"ttl_seconds,omitempty"`
+}
+
+func (a StockAdjustment) Validate() error {
+	if a.Quantity <= 0 {
+		return &ValidationError{Field: "quantity", Message: "quantity must be greater than 0"}
+	}
+	return validateReason(a.Reason)
+}
+
+func (c StockCorrection) Validate() error {
+	if c.OnHand < 0 {
+		return &ValidationError{Field: "on_hand", Message: "on_hand must not be negative"}
+	}
+	if c.Reason == "" {
+		return &ValidationError{Field: "reason", Message: "reason is required for corrections"}
+	}
+	return validateReason(c.Reason)
+}
+
+func NewReservation(itemID uuid.UUID, input ReservationInput, actor string, now time.Time) (*Reservation, error) {
+	if input.Quantity <= 0 {
+		return nil, &ValidationError{Field: "quantity", Message: "quantity must be greater than 0"}
+	}
+	ttl := DefaultReservationTTL
+	if input.TTLSeconds != 0 {
+		ttl = time.Duration(input.TTLSeconds) * time.Second
+	}
+	if ttl <= 0 || ttl > MaxReservationTTL {
+		return nil, &ValidationError{Field: "ttl_seconds", Message: fmt.Sprintf("ttl_seconds must be between 1 and %d", int(MaxReservationTTL.Seconds()))}
+	}
+	return &Reservation{
+		ID:        uuid.New(),
+		ItemID:    itemID,
+		Quantity:  input.Quantity,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		CreatedBy: actor,
+	}, nil
+}
+
+func validateReason(reason string) error {
+	if len(reason) > MaxReasonLength {
+		return &ValidationError{Field: "reason", Message: fmt.Sprintf("reason must be at most %d characters", MaxReasonLength)}
+	}
+	return nil
+}
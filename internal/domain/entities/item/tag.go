@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const MaxTagLength = 50
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+func NormalizeTag(tag string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(tag))
+	if normalized == "" {
+		return "", &ValidationError{Field: "tag", Message: "tag is required"}
+	}
+	if len(normalized) > MaxTagLength {
+		return "", &ValidationError{Field: "tag", Message: fmt.Sprintf("tag must be at most %d characters", MaxTagLength)}
+	}
+	if !tagPattern.MatchString(normalized) {
+		return "", &ValidationError{Field: "tag", Message: fmt.Sprintf("tag '%s' may only contain letters, digits, '-' and '_'", tag)}
+	}
+	return normalized, nil
+}
+
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		t, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[t] {
+			seen[t] = true
+			normalized = append(normalized, t)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+func (i *Item) HasTag(tag string) bool {
+	for _, t := range i.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const DefaultLocale = "en"
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z][a-z]{3})?(-([A-Z]{2}|[0-9]{3}))?$`)
+
+type Translation struct {
+	Locale      string    `json:"locale"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	UpdatedBy   string    `json:"updated_by"`
+}
+
+type TranslationInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func NormalizeLocale(tag string) (string, error) {
+	subtags := strings.Split(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	for i, subtag := range subtags {
+		switch {
+		case i == 0:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 4:
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		default:
+			subtags[i] = strings.ToUpper(subtag)
+		}
+	}
+	normalized := strings.Join(subtags, "-")
+	if !localePattern.MatchString(normalized) {
+		return "", &ValidationError{Field: "locale", Message: fmt.Sprintf("locale '%s' must be a language tag such as pt or pt-BR", tag)}
+	}
+	return normalized, nil
+}
+
+func LocaleChain(preferred []string) []string {
+	seen := map[string]bool{}
+	var chain []string
+	add := func(locale string) {
+		if !seen[locale] {
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+	for _, tag := range preferred {
+		locale, err := NormalizeLocale(tag)
+		if err != nil {
+			continue
+		}
+		for {
+			add(locale)
+			i := strings.LastIndex(locale, "-")
+			if i < 0 {
+				break
+			}
+			locale = locale[:i]
+		}
+	}
+	add(DefaultLocale)
+	return chain
+}
+
+func NewTranslation(locale string, input TranslationInput, actor string, at time.Time) (*Translation, error) {
+	normalized, err := NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if normalized == DefaultLocale {
+		return nil, &ValidationError{Field: "locale", Message: fmt.Sprintf("the %s name and description are stored on the item itself", DefaultLocale)}
+	}
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, &ValidationError{Field: "name", Message: "name is required"}
+	}
+	if strings.TrimSpace(input.Description) == "" {
+		return nil, &ValidationError{Field: "description", Message: "description is required"}
+	}
+	return &Translation{
+		Locale:      normalized,
+		Name:        input.Name,
+		Description: input.Description,
+		UpdatedAt:   at,
+		UpdatedBy:   actor,
+	}, nil
+}
+
+func (i *Item) Localize(chain []string, translations map[string]*Translation) string {
+	for _, locale := range chain {
+		if locale == DefaultLocale {
+			break
+		}
+		if t, ok := translations[locale]; ok {
+			i.DisplayName = t.Name
+			i.Description = t.Description
+			return locale
+		}
+	}
+	return DefaultLocale
+}
@@ -0,0 +1,161 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxVariantOptions      = 8
+	MaxVariantOptionLength = 64
+)
+
+var (
+	skuPattern           = regexp.MustCompile(`^[A-Z0-9][A-Z0-9._-]{0,63}$`)
+	variantOptionPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+)
+
+type Variant struct {
+	ID             uuid.UUID       `json:"id"`
+	SKU            string          `json:"sku"`
+	Options        []VariantOption `json:"options"`
+	Price          *float64        `json:"price,omitempty"`
+	EffectivePrice float64         `json:"effective_price"`
+	Currency       string          `json:"currency"`
+	CreatedAt      time.Time       `json:"created_at"`
+	CreatedBy      string          `json:"created_by"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	UpdatedBy      string          `json:"updated_by"`
+}
+
+type VariantOption struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type VariantInput struct {
+	Options []VariantOption `json:"options"`
+	Price   *float64        `json:"price,omitempty"`
+}
+
+func NormalizeSKU(sku string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(sku))
+	if !skuPattern.MatchString(normalized) {
+		return "", &ValidationError{Field: "sku", Message: fmt.Sprintf("SKU '%s' must be 1-64 letters, digits, '.', '-' or '_'", sku)}
+	}
+	return normalized, nil
+}
+
+func NewVariant(sku string, input VariantInput, actor string, at time.Time) (*Variant, error) {
+	normalized, err := NormalizeSKU(sku)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.Options) == 0 {
+		return nil, &ValidationError{Field: "options", Message: "at least one option is required"}
+	}
+	if len(input.Options) > MaxVariantOptions {
+		return nil, &ValidationError{Field: "options", Message: fmt.Sprintf("a variant may have at most %d options", MaxVariantOptions)}
+	}
+	if input.Price != nil && *input.Price <= 0 {
+		return nil, &ValidationError{Field: "price", Message: "price must be greater than 0"}
+	}
+
+	seen := map[string]bool{}
+	options := make([]VariantOption, 0, len(input.Options))
+	for _, option := range input.Options {
+		name := strings.ToLower(strings.TrimSpace(option.Name))
+		if !variantOptionPattern.MatchString(name) {
+			return nil, &ValidationError{Field: "options", Message: fmt.Sprintf("option name '%s' must start with a letter and contain only letters, digits and '_'", option.Name)}
+		}
+		if seen[name] {
+			return nil, &ValidationError{Field: "options", Message: fmt.Sprintf("option '%s' is given more than once", name)}
+		}
+		seen[name] = true
+		value := strings.TrimSpace(option.Value)
+		if value == "" {
+			return nil, &ValidationError{Field: "options", Message: fmt.Sprintf("option '%s' needs a value", name)}
+		}
+		if len(value) > MaxVariantOptionLength {
+			return nil, &ValidationError{Field: "options", Message: fmt.Sprintf("option '%s' must be at most %d characters", name, MaxVariantOptionLength)}
+		}
+		options = append(options, VariantOption{Name: name, Value: value})
+	}
+	sort.Slice(options, func(i, j int) bool { return options[i].Name < options[j].Name })
+
+	var price *float64
+	if input.Price != nil {
+		p := *input.Price
+		price = &p
+	}
+	return &Variant{
+		ID:        uuid.New(),
+		SKU:       normalized,
+		Options:   options,
+		Price:     price,
+		CreatedAt: at,
+		CreatedBy: actor,
+		UpdatedAt: at,
+		UpdatedBy: actor,
+	}, nil
+}
+
+func (v *Variant) OptionKey() string {
+	values := make(map[string]string, len(v.Options))
+	for _, option := range v.Options {
+		values[option.Name] = option.Value
+	}
+	encoded, _ := json.Marshal(values)
+	return string(encoded)
+}
+
+func (v *Variant) dimensions() string {
+	names := make([]string, len(v.Options))
+	for i, option := range v.Options {
+		names[i] = option.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func (i *Item) Variant(sku string) *Variant {
+	for _, v := range i.Variants {
+		if v.SKU == sku {
+			return v
+		}
+	}
+	return nil
+}
+
+func (i *Item) CheckVariant(v *Variant) error {
+	for _, other := range i.Variants {
+		if other.SKU == v.SKU {
+			continue
+		}
+		if other.dimensions() != v.dimensions() {
+			return &ValidationError{Field: "options", Message: fmt.Sprintf("variants of item '%s' are defined by %s", i.Name, other.dimensions())}
+		}
+		if other.OptionKey() == v.OptionKey() {
+			return fmt.Errorf("variant %s of item '%s' already has these options: %w", other.SKU, i.Name, ErrVariantAlreadyExists)
+		}
+	}
+	return nil
+}
+
+func (i *Item) PriceVariant(v *Variant) {
+	v.EffectivePrice, v.Currency = i.Price, i.Currency
+	if v.Price != nil {
+		v.EffectivePrice = *v.Price
+	}
+}
+
+func (i *Item) PriceVariants() {
+	for _, v := range i.Variants {
+		i.PriceVariant(v)
+	}
+}
@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+)
+
+const MinSecretLength = 16
+
+var EventTypes = []string{string(events.ItemCreated), string(events.ItemUpdated), string(events.ItemDeleted)}
+
+type Webhook struct {
+	ID        uuid.UUID `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Input struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events,omitempty"`
+	Secret string   `json:"secret,omitempty"`
+	Active *bool    `json:"active,omitempty"`
+}
+
+func NewWebhook(input Input) (*Webhook, error) {
+	hook := &Webhook{
+		ID:        uuid.New(),
+		Active:    true,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := hook.Apply(input); err != nil {
+		return nil, err
+	}
+	if hook.Secret == "" {
+		secret, err := GenerateSecret()
+		if err != nil {
+			return nil, err
+		}
+		hook.Secret = secret
+	}
+	return hook, nil
+}
+
+func (w *Webhook) Apply(input Input) error {
+	target, err := url.Parse(input.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return &ValidationError{Field: "url", Message: "url must be an absolute http or https URL"}
+	}
+	for _, eventType := range input.Events {
+		if !slices.Contains(EventTypes, eventType) {
+			return &ValidationError{Field: "events", Message: "unknown event type: " + eventType}
+		}
+	}
+	if input.Secret != "" && len(input.Secret) < MinSecretLength {
+		return &ValidationError{Field: "secret", Message: "secret must be at least 16 characters"}
+	}
+
+	subscribed := append([]string{}, input.Events...)
+	slices.Sort(subscribed)
+	w.URL = target.String()
+	w.Events = slices.Compact(subscribed)
+	if input.Secret != "" {
+		w.Secret = input.Secret
+	}
+	if input.Active != nil {
+		w.Active = *input.Active
+	}
+	return nil
+}
+
+func (w *Webhook) Matches(eventType string) bool {
+	return w.Active && (len(w.Events) == 0 || slices.Contains(w.Events, eventType))
+}
+
+func (w *Webhook) Redacted() *Webhook {
+	copied := *w
+	copied.Secret = ""
+	return &copied
+}
+
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,115 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type Type string
+
+const (
+	ItemCreated Type = "item.created"
+	ItemUpdated Type = "item.updated"
+	ItemDeleted Type = "item.deleted"
+)
+
+const DefaultReplaySize = 1024
+
+type Event struct {
+	ID         uint64         `json:"id"`
+	Type       Type           `json:"type"`
+	Item       *entities.Item `json:"item"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+type Bus struct {
+	mu          sync.Mutex
+	nextEventID uint64
+	nextSubID   int
+	subscribers map[int]chan Event
+	replay      []Event
+	replaySize  int
+}
+
+func NewBus() *Bus {
+	return NewBusWithReplay(DefaultReplaySize)
+}
+
+func NewBusWithReplay(replaySize int) *Bus {
+	return &Bus{subscribers: make(map[int]chan Event), replaySize: replaySize}
+}
+
+func (b *Bus) Publish(eventType Type, item *entities.Item) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextEventID++
+	evt := Event{ID: b.nextEventID, Type: eventType, Item: item, OccurredAt: time.Now().UTC()}
+
+	if b.replaySize > 0 {
+		if len(b.replay) == b.replaySize {
+			copy(b.replay, b.replay[1:])
+			b.replay = b.replay[:len(b.replay)-1]
+		}
+		b.replay = append(b.replay, evt)
+	}
+
+	for id, ch := range b.subscribers {
+		select {
+		case ch <- evt:
+		default:
+			delete(b.subscribers, id)
+			close(ch)
+		}
+	}
+	return evt
+}
+
+func (b *Bus) Subscribe(buffer int) (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.subscribeLocked(buffer)
+}
+
+func (b *Bus) SubscribeFrom(lastEventID uint64, buffer int) ([]Event, bool, <-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	complete := lastEventID <= b.nextEventID
+	if len(b.replay) > 0 && b.replay[0].ID > lastEventID+1 {
+		complete = false
+	}
+	if len(b.replay) == 0 && lastEventID < b.nextEventID {
+		complete = false
+	}
+
+	var missed []Event
+	for _, evt := range b.replay {
+		if evt.ID > lastEventID || !complete {
+			missed = append(missed, evt)
+		}
+	}
+
+	ch, unsubscribe := b.subscribeLocked(buffer)
+	return missed, complete, ch, unsubscribe
+}
+
+func (b *Bus) subscribeLocked(buffer int) (<-chan Event, func()) {
+	id := b.nextSubID
+	b.nextSubID++
+	ch := make(chan Event, buffer)
+	b.subscribers[id] = ch
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if current, ok := b.subscribers[id]; ok && current == ch {
+			delete(b.subscribers, id)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/blob"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+const sniffLen = 512
+
+type AttachmentUseCase_Impl struct {
+	Repo    repositories.AttachmentRepository
+	Items   repositories.ItemRepository
+	Blobs   blob.Store
+	MaxSize int64
+	Now     func() time.Time
+	Actor   string
+}
+
+func NewAttachmentUseCase(repo repositories.AttachmentRepository, items repositories.ItemRepository, blobs blob.Store) *AttachmentUseCase_Impl {
+	return &AttachmentUseCase_Impl{Repo: repo, Items: items, Blobs: blobs, MaxSize: entities.MaxAttachmentSize, Now: time.Now}
+}
+
+func (uc *AttachmentUseCase_Impl) WithActor(actor string) AttachmentUseCase {
+	scoped := *uc
+	scoped.Actor = actor
+	return &scoped
+}
+
+func (uc *AttachmentUseCase_Impl) ListAttachments(name string) ([]*entities.Attachment, error) {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.ListAttachments(item.ID)
+}
+
+func (uc *AttachmentUseCase_Impl) GetAttachment(name string, id uuid.UUID) (*entities.Attachment, error) {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.GetAttachment(item.ID, id)
+}
+
+func (uc *AttachmentUseCase_Impl) UploadAttachment(name string, filename string, content io.Reader) (*entities.Attachment, error) {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	buffered := bufio.NewReaderSize(content, sniffLen)
+	head, err := buffered.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if len(head) == 0 {
+		return nil, &entities.ValidationError{Field: "file", Message: "file is empty"}
+	}
+
+	attachment, err := entities.NewAttachment(filename, http.DetectContentType(head), uc.actor(), uc.now())
+	if err != nil {
+		return nil, err
+	}
+
+	key := attachmentKey(item.ID, attachment.ID)
+	hash := sha256.New()
+	size, err := uc.Blobs.Put(key, io.TeeReader(io.LimitReader(buffered, uc.MaxSize+1), hash))
+	if err != nil {
+		return nil, err
+	}
+	if size > uc.MaxSize {
+		uc.discard(key)
+		return nil, fmt.Errorf("attachment must not exceed %d bytes: %w", uc.MaxSize, entities.ErrAttachmentTooLarge)
+	}
+	attachment.Size = size
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err := uc.Repo.AddAttachment(item.ID, attachment); err != nil {
+		uc.discard(key)
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (uc *AttachmentUseCase_Impl) OpenAttachment(name string, id uuid.UUID) (*entities.Attachment, io.ReadSeekCloser, error) {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	attachment, err := uc.Repo.GetAttachment(item.ID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := uc.Blobs.Open(attachmentKey(item.ID, attachment.ID))
+	if errors.Is(err, blob.ErrNotFound) {
+		return nil, nil, fmt.Errorf("content of attachment '%s' is missing: %w", id, entities.ErrAttachmentNotFound)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
+
+func (uc *AttachmentUseCase_Impl) DeleteAttachment(name string, id uuid.UUID) error {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return err
+	}
+	if err := uc.Repo.DeleteAttachment(item.ID, id); err != nil {
+		return err
+	}
+	uc.discard(attachmentKey(item.ID, id))
+	return nil
+}
+
+func (uc *AttachmentUseCase_Impl) discard(key string) {
+	if err := uc.Blobs.Delete(key); err != nil {
+		log.Printf("Failed to delete attachment blob %s: %v", key, err)
+	}
+}
+
+func (uc *AttachmentUseCase_Impl) actor() string {
+	if uc.Actor == "" {
+		return AnonymousActor
+	}
+	return uc.Actor
+}
+
+func (uc *AttachmentUseCase_Impl) now() time.Time {
+	if uc.Now == nil {
+		return time.Now().UTC()
+	}
+	return uc.Now().UTC()
+}
+
+func attachmentKey(itemID uuid.UUID, id uuid.UUID) string {
+	return itemID.String() + "/" + id.String()
+}
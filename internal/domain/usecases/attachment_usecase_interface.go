@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type AttachmentUseCase interface {
+	WithActor(actor string) AttachmentUseCase
+	ListAttachments(name string) ([]*entities.Attachment, error)
+	GetAttachment(name string, id uuid.UUID) (*entities.Attachment, error)
+	UploadAttachment(name string, filename string, content io.Reader) (*entities.Attachment, error)
+	OpenAttachment(name string, id uuid.UUID) (*entities.Attachment, io.ReadSeekCloser, error)
+	DeleteAttachment(name string, id uuid.UUID) error
+}
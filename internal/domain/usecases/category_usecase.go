@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type CategoryUseCase_Impl struct {
+	Repo  repositories.CategoryRepository
+	Items repositories.ItemRepository
+}
+
+func NewCategoryUseCase(repo repositories.CategoryRepository, items repositories.ItemRepository) *CategoryUseCase_Impl {
+	return &CategoryUseCase_Impl{Repo: repo, Items: items}
+}
+
+func (uc *CategoryUseCase_Impl) ListCategories() ([]*category.Category, error) {
+	return uc.Repo.ListCategories()
+}
+
+func (uc *CategoryUseCase_Impl) GetCategory(id uuid.UUID) (*category.Category, error) {
+	return uc.Repo.GetCategory(id)
+}
+
+func (uc *CategoryUseCase_Impl) CreateCategory(input category.Input) (*category.Category, error) {
+	c, err := category.NewCategory(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.CreateCategory(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (uc *CategoryUseCase_Impl) UpdateCategory(id uuid.UUID, input category.Input) (*category.Category, error) {
+	c, err := uc.Repo.GetCategory(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Apply(input); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.UpdateCategory(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (uc *CategoryUseCase_Impl) DeleteCategory(id uuid.UUID) error {
+	return uc.Repo.DeleteCategory(id)
+}
+
+func (uc *CategoryUseCase_Impl) ListItems(id uuid.UUID, includeDescendants bool) ([]*entities.Item, error) {
+	return uc.Repo.ListItems(id, includeDescendants)
+}
+
+func (uc *CategoryUseCase_Impl) AddItem(id uuid.UUID, itemName string) error {
+	item, err := uc.Items.GetItemByName(itemName)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.AddItem(id, item.ID)
+}
+
+func (uc *CategoryUseCase_Impl) RemoveItem(id uuid.UUID, itemName string) error {
+	item, err := uc.Items.GetItemByName(itemName)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.RemoveItem(id, item.ID)
+}
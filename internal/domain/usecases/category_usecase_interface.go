@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type CategoryUseCase interface {
+	ListCategories() ([]*category.Category, error)
+	GetCategory(id uuid.UUID) (*category.Category, error)
+	CreateCategory(input category.Input) (*category.Category, error)
+	UpdateCategory(id uuid.UUID, input category.Input) (*category.Category, error)
+	DeleteCategory(id uuid.UUID) error
+	ListItems(id uuid.UUID, includeDescendants bool) ([]*entities.Item, error)
+	AddItem(id uuid.UUID, itemName string) error
+	RemoveItem(id uuid.UUID, itemName string) error
+}
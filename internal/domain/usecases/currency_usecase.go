@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type CurrencyUseCase_Impl struct {
+	Repo  repositories.CurrencyRepository
+	Items repositories.ItemRepository
+	Now   func() time.Time
+}
+
+func NewCurrencyUseCase(repo repositories.CurrencyRepository, items repositories.ItemRepository) *CurrencyUseCase_Impl {
+	return &CurrencyUseCase_Impl{Repo: repo, Items: items, Now: time.Now}
+}
+
+func (uc *CurrencyUseCase_Impl) ListRates() ([]*entities.ExchangeRate, error) {
+	return uc.Repo.ListRates()
+}
+
+func (uc *CurrencyUseCase_Impl) SetRate(currency string, input entities.ExchangeRateInput) (*entities.ExchangeRate, error) {
+	rate, err := entities.NewExchangeRate(currency, input, uc.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.SetRate(rate); err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
+
+func (uc *CurrencyUseCase_Impl) DeleteRate(currency string) error {
+	normalized, err := entities.NormalizeCurrency(currency)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.DeleteRate(normalized)
+}
+
+func (uc *CurrencyUseCase_Impl) LoadRates(r io.Reader) (int, error) {
+	var table map[string]float64
+	if err := json.NewDecoder(r).Decode(&table); err != nil {
+		return 0, fmt.Errorf("failed to decode exchange rates: %v", err)
+	}
+
+	currencies := make([]string, 0, len(table))
+	for currency := range table {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	now := uc.now()
+	rates := make([]*entities.ExchangeRate, 0, len(currencies))
+	for _, currency := range currencies {
+		rate, err := entities.NewExchangeRate(currency, entities.ExchangeRateInput{Rate: table[currency]}, now)
+		if err != nil {
+			return 0, fmt.Errorf("invalid exchange rate for '%s': %w", currency, err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := uc.Repo.SetRates(rates); err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
+
+func (uc *CurrencyUseCase_Impl) ListItemPrices(name string) ([]*entities.CurrencyPrice, error) {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.ListItemPrices(item.ID)
+}
+
+func (uc *CurrencyUseCase_Impl) SetItemPrice(name string, currency string, input entities.CurrencyPriceInput) (*entities.CurrencyPrice, error) {
+	price, err := entities.NewCurrencyPrice(currency, input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.SetItemPrice(item.ID, price); err != nil {
+		return nil, err
+	}
+	return price, nil
+}
+
+func (uc *CurrencyUseCase_Impl) DeleteItemPrice(name string, currency string) error {
+	normalized, err := entities.NormalizeCurrency(currency)
+	if err != nil {
+		return err
+	}
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.DeleteItemPrice(item.ID, normalized)
+}
+
+func (uc *CurrencyUseCase_Impl) Convert(items []*entities.Item, currency string) ([]*entities.Item, error) {
+	target, err := entities.NormalizeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	listed, err := uc.Repo.ListRates()
+	if err != nil {
+		return nil, err
+	}
+	rates := make(map[string]float64, len(listed))
+	for _, rate := range listed {
+		rates[rate.Currency] = rate.Rate
+	}
+	explicit, err := uc.Repo.PricesIn(target)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]*entities.Item, 0, len(items))
+	for _, item := range items {
+		copied := *item
+		if price, ok := explicit[item.ID]; ok {
+			copied.Price, copied.Currency = price, target
+		} else if err := copied.ConvertTo(target, rates); err != nil {
+			return nil, err
+		}
+		converted = append(converted, &copied)
+	}
+	return converted, nil
+}
+
+func (uc *CurrencyUseCase_Impl) now() time.Time {
+	if uc.Now == nil {
+		return time.Now().UTC()
+	}
+	return uc.Now().UTC()
+}
@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"io"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type CurrencyUseCase interface {
+	ListRates() ([]*entities.ExchangeRate, error)
+	SetRate(currency string, input entities.ExchangeRateInput) (*entities.ExchangeRate, error)
+	DeleteRate(currency string) error
+	LoadRates(r io.Reader) (int, error)
+	ListItemPrices(name string) ([]*entities.CurrencyPrice, error)
+	SetItemPrice(name string, currency string, input entities.CurrencyPriceInput) (*entities.CurrencyPrice, error)
+	DeleteItemPrice(name string, currency string) error
+	Convert(items []*entities.Item, currency string) ([]*entities.Item, error)
+}
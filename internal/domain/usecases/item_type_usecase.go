@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type ItemTypeUseCase_Impl struct {
+	Repo  repositories.ItemTypeRepository
+	Items repositories.ItemRepository
+	Now   func() time.Time
+	Actor string
+}
+
+func NewItemTypeUseCase(repo repositories.ItemTypeRepository, items repositories.ItemRepository) *ItemTypeUseCase_Impl {
+	return &ItemTypeUseCase_Impl{Repo: repo, Items: items, Now: time.Now}
+}
+
+func (uc *ItemTypeUseCase_Impl) WithActor(actor string) ItemTypeUseCase {
+	scoped := *uc
+	scoped.Actor = actor
+	return &scoped
+}
+
+func (uc *ItemTypeUseCase_Impl) ListItemTypes() ([]*entities.ItemType, error) {
+	return uc.Repo.ListItemTypes()
+}
+
+func (uc *ItemTypeUseCase_Impl) GetItemType(name string) (*entities.ItemType, error) {
+	normalized, err := entities.NormalizeItemTypeName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.GetItemType(normalized)
+}
+
+func (uc *ItemTypeUseCase_Impl) PutItemType(name string, input entities.ItemTypeInput) (*entities.ItemType, bool, error) {
+	itemType, err := entities.NewItemType(name, input, uc.actor(), uc.now())
+	if err != nil {
+		return nil, false, err
+	}
+
+	existing, err := uc.Repo.GetItemType(itemType.Name)
+	created := errors.Is(err, entities.ErrItemTypeNotFound)
+	if err != nil && !created {
+		return nil, false, err
+	}
+	if !created {
+		itemType.CreatedAt, itemType.CreatedBy = existing.CreatedAt, existing.CreatedBy
+		items, err := uc.Items.ListItems(entities.ItemQuery{Type: itemType.Name})
+		if err != nil {
+			return nil, false, err
+		}
+		for _, item := range items {
+			if _, err := itemType.ValidateAttributes(item.Attributes); err != nil {
+				return nil, false, fmt.Errorf("item '%s' does not match the new schema (%v): %w", item.Name, err, entities.ErrItemTypeInUse)
+			}
+		}
+	}
+
+	if err := uc.Repo.SaveItemType(itemType); err != nil {
+		return nil, false, err
+	}
+	return itemType, created, nil
+}
+
+func (uc *ItemTypeUseCase_Impl) DeleteItemType(name string) error {
+	normalized, err := entities.NormalizeItemTypeName(name)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.DeleteItemType(normalized)
+}
+
+func (uc *ItemTypeUseCase_Impl) actor() string {
+	if uc.Actor == "" {
+		return AnonymousActor
+	}
+	return uc.Actor
+}
+
+func (uc *ItemTypeUseCase_Impl) now() time.Time {
+	if uc.Now == nil {
+		return time.Now().UTC()
+	}
+	return uc.Now().UTC()
+}
@@ -0,0 +1,13 @@
+package usecases
+
+import (
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type ItemTypeUseCase interface {
+	WithActor(actor string) ItemTypeUseCase
+	ListItemTypes() ([]*entities.ItemType, error)
+	GetItemType(name string) (*entities.ItemType, error)
+	PutItemType(name string, input entities.ItemTypeInput) (*entities.ItemType, bool, error)
+	DeleteItemType(name string) error
+}
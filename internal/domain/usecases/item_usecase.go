@@ -1,34 +1,241 @@
 package usecases
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
 	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+	"github.com/google/uuid"
 )
 
+const AnonymousActor = "anonymous"
+
 type ItemUseCase_Impl struct {
-	Repo repositories.ItemRepository
+	Repo   repositories.ItemRepository
+	Types  repositories.ItemTypeRepository
+	Events *events.Bus
+	Now    func() time.Time
+	Actor  string
 }
 
 func NewItemUseCase(repo repositories.ItemRepository) *ItemUseCase_Impl {
-	return &ItemUseCase_Impl{Repo: repo}
+	return &ItemUseCase_Impl{Repo: repo, Events: events.NewBus(), Now: time.Now}
+}
+
+func (uc *ItemUseCase_Impl) WithActor(actor string) ItemUseCase {
+	scoped := *uc
+	scoped.Actor = actor
+	return &scoped
 }
 
 func (uc *ItemUseCase_Impl) GetItems() ([]*entities.Item, error) {
 	return uc.Repo.GetItems()
 }
 
+func (uc *ItemUseCase_Impl) ListItems(query entities.ItemQuery) ([]*entities.Item, error) {
+	query.Statuses = listedStatuses(query.Statuses)
+	return uc.Repo.ListItems(query)
+}
+
+func (uc *ItemUseCase_Impl) ExportItems(statuses []string, fn func(item *entities.Item) error) error {
+	return uc.Repo.StreamItems(listedStatuses(statuses), fn)
+}
+
 func (uc *ItemUseCase_Impl) GetItemByName(name string) (*entities.Item, error) {
 	return uc.Repo.GetItemByName(name)
 }
 
-func (uc *ItemUseCase_Impl) CreateItem(itm *entities.Item) error {
-	return uc.Repo.CreateItem(itm)
+func (uc *ItemUseCase_Impl) GetItemByID(id uuid.UUID) (*entities.Item, error) {
+	return uc.Repo.GetItemByID(id)
 }
 
-func (uc *ItemUseCase_Impl) UpdateItem(name string, itm *entities.Item) error {
-	return uc.Repo.UpdateItem(name, itm)
+func (uc *ItemUseCase_Impl) GetCollectionVersion() (*entities.CollectionVersion, error) {
+	return uc.Repo.GetCollectionVersion()
+}
+
+func (uc *ItemUseCase_Impl) CreateItem(itm *entities.Item) (*entities.Item, error) {
+	if err := itm.Validate(); err != nil {
+		return nil, err
+	}
+	if err := normalizeItemCurrency(itm); err != nil {
+		return nil, err
+	}
+	if err := applyItemStatus(itm, nil); err != nil {
+		return nil, err
+	}
+	if err := uc.applyItemType(itm, nil); err != nil {
+		return nil, err
+	}
+	itm.MarkCreated(uc.actor(), uc.now())
+	created, err := uc.Repo.CreateItem(itm)
+	if err != nil {
+		return nil, err
+	}
+	uc.Events.Publish(events.ItemCreated, created)
+	return created, nil
+}
+
+func (uc *ItemUseCase_Impl) ImportItems(items []*entities.Item) error {
+	actor, now := uc.actor(), uc.now()
+	for _, itm := range items {
+		if err := normalizeItemCurrency(itm); err != nil {
+			return err
+		}
+		if err := applyItemStatus(itm, nil); err != nil {
+			return fmt.Errorf("item '%s': %w", itm.Name, err)
+		}
+		if err := uc.applyItemType(itm, nil); err != nil {
+			return fmt.Errorf("item '%s': %w", itm.Name, err)
+		}
+		itm.MarkCreated(actor, now)
+	}
+	err := uc.Repo.ImportItems(items)
+	if err != nil {
+		return err
+	}
+	for _, itm := range items {
+		uc.Events.Publish(events.ItemCreated, itm)
+	}
+	return nil
+}
+
+func (uc *ItemUseCase_Impl) UpdateItem(name string, itm *entities.Item) (*entities.Item, error) {
+	if err := itm.Validate(); err != nil {
+		return nil, err
+	}
+	if err := normalizeItemCurrency(itm); err != nil {
+		return nil, err
+	}
+	existing, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyItemStatus(itm, existing); err != nil {
+		return nil, err
+	}
+	if err := uc.applyItemType(itm, existing); err != nil {
+		return nil, err
+	}
+	itm.MarkUpdated(uc.actor(), uc.now())
+	updated, err := uc.Repo.UpdateItem(name, itm)
+	if err != nil {
+		return nil, err
+	}
+	uc.Events.Publish(events.ItemUpdated, updated)
+	return updated, nil
 }
 
 func (uc *ItemUseCase_Impl) DeleteItem(name string) error {
-	return uc.Repo.DeleteItem(name)
+	existing, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return err
+	}
+	err = uc.Repo.DeleteItem(name)
+	if err != nil {
+		return err
+	}
+	uc.Events.Publish(events.ItemDeleted, existing)
+	return nil
+}
+
+func (uc *ItemUseCase_Impl) AddTag(name string, tag string) (*entities.Item, error) {
+	normalized, err := entities.NormalizeTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	tagged, added, err := uc.Repo.AddTag(name, normalized, uc.actor(), uc.now())
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		uc.Events.Publish(events.ItemUpdated, tagged)
+	}
+	return tagged, nil
+}
+
+func (uc *ItemUseCase_Impl) RemoveTag(name string, tag string) (*entities.Item, error) {
+	normalized, err := entities.NormalizeTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	untagged, err := uc.Repo.RemoveTag(name, normalized, uc.actor(), uc.now())
+	if err != nil {
+		return nil, err
+	}
+	uc.Events.Publish(events.ItemUpdated, untagged)
+	return untagged, nil
+}
+
+func (uc *ItemUseCase_Impl) ListTags() ([]*entities.TagCount, error) {
+	return uc.Repo.ListTags()
+}
+
+func normalizeItemCurrency(itm *entities.Item) error {
+	if itm.Currency == "" {
+		return nil
+	}
+	currency, err := entities.NormalizeCurrency(itm.Currency)
+	if err != nil {
+		return err
+	}
+	itm.Currency = currency
+	return nil
+}
+
+func (uc *ItemUseCase_Impl) applyItemType(itm *entities.Item, existing *entities.Item) error {
+	if existing != nil {
+		if itm.Type == "" {
+			itm.Type = existing.Type
+		}
+		if len(itm.Attributes) == 0 && strings.EqualFold(strings.TrimSpace(itm.Type), existing.Type) {
+			itm.Attributes = existing.Attributes
+		}
+	}
+	if itm.Type == "" {
+		if len(itm.Attributes) > 0 && !bytes.Equal(bytes.TrimSpace(itm.Attributes), []byte("null")) {
+			return &entities.ValidationError{Field: "attributes", Message: "attributes require an item type"}
+		}
+		itm.Attributes = nil
+		return nil
+	}
+
+	name, err := entities.NormalizeItemTypeName(itm.Type)
+	if err != nil {
+		return err
+	}
+	if uc.Types == nil {
+		return &entities.ValidationError{Field: "type", Message: fmt.Sprintf("unknown item type '%s'", name)}
+	}
+	itemType, err := uc.Types.GetItemType(name)
+	if errors.Is(err, entities.ErrItemTypeNotFound) {
+		return &entities.ValidationError{Field: "type", Message: fmt.Sprintf("unknown item type '%s'", name)}
+	}
+	if err != nil {
+		return err
+	}
+	attributes, err := itemType.ValidateAttributes(itm.Attributes)
+	if err != nil {
+		return err
+	}
+	itm.Type, itm.Attributes = name, attributes
+	return nil
+}
+
+func (uc *ItemUseCase_Impl) actor() string {
+	if uc.Actor == "" {
+		return AnonymousActor
+	}
+	return uc.Actor
+}
+
+func (uc *ItemUseCase_Impl) now() time.Time {
+	if uc.Now == nil {
+		return time.Now().UTC()
+	}
+	return uc.Now().UTC()
 }
@@ -1,11 +1,41 @@
 package usecases
 
-import entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+import (
+	"time"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/google/uuid"
+)
 
 type ItemUseCase interface {
+	WithActor(actor string) ItemUseCase
 	GetItems() ([]*entities.Item, error)
+	ListItems(query entities.ItemQuery) ([]*entities.Item, error)
+	ExportItems(statuses []string, fn func(item *entities.Item) error) error
 	GetItemByName(name string) (*entities.Item, error)
-	CreateItem(item *entities.Item) error
-	UpdateItem(name string, item *entities.Item) error
+	GetItemByID(id uuid.UUID) (*entities.Item, error)
+	GetCollectionVersion() (*entities.CollectionVersion, error)
+	CreateItem(item *entities.Item) (*entities.Item, error)
+	ImportItems(items []*entities.Item) error
+	UpdateItem(name string, item *entities.Item) (*entities.Item, error)
 	DeleteItem(name string) error
+	TransitionItem(name string, action string) (*entities.Item, error)
+	AddTag(name string, tag string) (*entities.Item, error)
+	RemoveTag(name string, tag string) (*entities.Item, error)
+	ListTags() ([]*entities.TagCount, error)
+	GetItemAt(name string, at time.Time) (*entities.Item, error)
+	ListPriceChanges(name string) ([]*entities.PriceChange, error)
+	GetPriceChange(name string, id uuid.UUID) (*entities.PriceChange, error)
+	SchedulePriceChange(name string, schedule entities.PriceSchedule) (*entities.PriceChange, error)
+	CancelPriceChange(name string, id uuid.UUID) error
+	ApplyDuePriceChanges(limit int) (int, error)
+	ListTranslations(name string) ([]*entities.Translation, error)
+	GetTranslation(name string, locale string) (*entities.Translation, error)
+	SetTranslation(name string, locale string, input entities.TranslationInput) (*entities.Translation, error)
+	DeleteTranslation(name string, locale string) error
+	ListVariants(name string) ([]*entities.Variant, error)
+	GetVariant(name string, sku string) (*entities.Variant, error)
+	PutVariant(name string, sku string, input entities.VariantInput) (*entities.Variant, bool, error)
+	DeleteVariant(name string, sku string) error
+	Localize(items []*entities.Item, chain []string) ([]*entities.Item, []string, error)
 }
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"fmt"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+)
+
+func (uc *ItemUseCase_Impl) TransitionItem(name string, action string) (*entities.Item, error) {
+	existing, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	to, err := existing.NextStatus(action)
+	if err != nil {
+		return nil, err
+	}
+	transitioned, err := uc.Repo.SetItemStatus(name, existing.Status, to, uc.actor(), uc.now())
+	if err != nil {
+		return nil, err
+	}
+	uc.Events.Publish(events.ItemUpdated, transitioned)
+	return transitioned, nil
+}
+
+func applyItemStatus(itm *entities.Item, existing *entities.Item) error {
+	if existing != nil {
+		if itm.Status != "" && itm.Status != existing.Status {
+			return fmt.Errorf("item '%s' is %s; change its status through the transition endpoints: %w", existing.Name, existing.Status, entities.ErrInvalidTransition)
+		}
+		itm.Status = existing.Status
+		return nil
+	}
+	if itm.Status == "" {
+		itm.Status = entities.StatusActive
+		return nil
+	}
+	status, err := entities.NormalizeStatus(itm.Status)
+	if err != nil {
+		return err
+	}
+	if status != entities.StatusDraft && status != entities.StatusActive {
+		return &entities.ValidationError{Field: "status", Message: fmt.Sprintf("new items start as %s or %s", entities.StatusDraft, entities.StatusActive)}
+	}
+	itm.Status = status
+	return nil
+}
+
+func listedStatuses(statuses []string) []string {
+	if statuses == nil {
+		return []string{entities.StatusActive}
+	}
+	return statuses
+}
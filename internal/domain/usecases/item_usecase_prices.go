@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+)
+
+func (uc *ItemUseCase_Impl) GetItemAt(name string, at time.Time) (*entities.Item, error) {
+	itm, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if at.Before(itm.CreatedAt) {
+		return nil, fmt.Errorf("item '%s' did not exist at %s: %w", name, at.UTC().Format(time.RFC3339), entities.ErrItemNotFound)
+	}
+
+	change, err := uc.Repo.GetPriceAt(itm.ID, at)
+	if errors.Is(err, entities.ErrPriceChangeNotFound) {
+		return itm, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	priced := *itm
+	priced.Price = change.Price
+	return &priced, nil
+}
+
+func (uc *ItemUseCase_Impl) ListPriceChanges(name string) ([]*entities.PriceChange, error) {
+	itm, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.ListPriceChanges(itm.ID)
+}
+
+func (uc *ItemUseCase_Impl) GetPriceChange(name string, id uuid.UUID) (*entities.PriceChange, error) {
+	itm, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.GetPriceChange(itm.ID, id)
+}
+
+func (uc *ItemUseCase_Impl) SchedulePriceChange(name string, schedule entities.PriceSchedule) (*entities.PriceChange, error) {
+	itm, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	change, err := entities.NewScheduledPriceChange(itm.ID, schedule, uc.actor(), uc.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.SchedulePriceChange(change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+func (uc *ItemUseCase_Impl) CancelPriceChange(name string, id uuid.UUID) error {
+	itm, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.CancelPriceChange(itm.ID, id)
+}
+
+func (uc *ItemUseCase_Impl) ApplyDuePriceChanges(limit int) (int, error) {
+	due, err := uc.Repo.DuePriceChanges(uc.now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, change := range due {
+		updated, err := uc.Repo.ApplyPriceChange(change.ID, uc.now())
+		if errors.Is(err, entities.ErrPriceChangeNotFound) {
+			continue
+		}
+		if err != nil {
+			return i, err
+		}
+		uc.Events.Publish(events.ItemUpdated, updated)
+	}
+	return len(due), nil
+}
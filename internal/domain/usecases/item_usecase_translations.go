@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+	"github.com/google/uuid"
+)
+
+func (uc *ItemUseCase_Impl) ListTranslations(name string) ([]*entities.Translation, error) {
+	itm, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.ListTranslations(itm.ID)
+}
+
+func (uc *ItemUseCase_Impl) GetTranslation(name string, locale string) (*entities.Translation, error) {
+	normalized, err := entities.NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	itm, err := uc.Repo.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.GetTranslation(itm.ID, normalized)
+}
+
+func (uc *ItemUseCase_Impl) SetTranslation(name string, locale string, input entities.TranslationInput) (*entities.Translation, error) {
+	translation, err := entities.NewTranslation(locale, input, uc.actor(), uc.now())
+	if err != nil {
+		return nil, err
+	}
+	translated, err := uc.Repo.SetTranslation(name, translation)
+	if err != nil {
+		return nil, err
+	}
+	uc.Events.Publish(events.ItemUpdated, translated)
+	return translation, nil
+}
+
+func (uc *ItemUseCase_Impl) DeleteTranslation(name string, locale string) error {
+	normalized, err := entities.NormalizeLocale(locale)
+	if err != nil {
+		return err
+	}
+	untranslated, err := uc.Repo.DeleteTranslation(name, normalized, uc.actor(), uc.now())
+	if err != nil {
+		return err
+	}
+	uc.Events.Publish(events.ItemUpdated, untranslated)
+	return nil
+}
+
+func (uc *ItemUseCase_Impl) Localize(items []*entities.Item, chain []string) ([]*entities.Item, []string, error) {
+	if len(chain) == 0 || chain[0] == entities.DefaultLocale {
+		return items, []string{entities.DefaultLocale}, nil
+	}
+
+	itemIDs := make([]uuid.UUID, len(items))
+	for i, itm := range items {
+		itemIDs[i] = itm.ID
+	}
+	translations, err := uc.Repo.TranslationsIn(itemIDs, chain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	used := map[string]bool{}
+	localized := make([]*entities.Item, 0, len(items))
+	for _, itm := range items {
+		copied := *itm
+		used[copied.Localize(chain, translations[itm.ID])] = true
+		localized = append(localized, &copied)
+	}
+
+	var locales []string
+	for _, locale := range chain {
+		if used[locale] {
+			locales = append(locales, locale)
+		}
+	}
+	if len(locales) == 0 {
+		locales = []string{entities.DefaultLocale}
+	}
+	return localized, locales, nil
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"fmt"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+)
+
+func (uc *ItemUseCase_Impl) ListVariants(name string) ([]*entities.Variant, error) {
+	itm, err := uc.Repo.GetItemWithVariants(name)
+	if err != nil {
+		return nil, err
+	}
+	return itm.Variants, nil
+}
+
+func (uc *ItemUseCase_Impl) GetVariant(name string, sku string) (*entities.Variant, error) {
+	normalized, err := entities.NormalizeSKU(sku)
+	if err != nil {
+		return nil, err
+	}
+	itm, err := uc.Repo.GetItemWithVariants(name)
+	if err != nil {
+		return nil, err
+	}
+	v := itm.Variant(normalized)
+	if v == nil {
+		return nil, fmt.Errorf("item '%s' has no variant %s: %w", name, normalized, entities.ErrVariantNotFound)
+	}
+	return v, nil
+}
+
+func (uc *ItemUseCase_Impl) PutVariant(name string, sku string, input entities.VariantInput) (*entities.Variant, bool, error) {
+	variant, err := entities.NewVariant(sku, input, uc.actor(), uc.now())
+	if err != nil {
+		return nil, false, err
+	}
+	itm, err := uc.Repo.GetItemWithVariants(name)
+	if err != nil {
+		return nil, false, err
+	}
+	if variant.Price != nil {
+		rounded := entities.RoundPrice(*variant.Price, itm.Currency)
+		if rounded <= 0 {
+			return nil, false, &entities.ValidationError{Field: "price", Message: "price must be greater than 0"}
+		}
+		variant.Price = &rounded
+	}
+	if err := itm.CheckVariant(variant); err != nil {
+		return nil, false, err
+	}
+
+	existing := itm.Variant(variant.SKU)
+	if existing != nil {
+		variant.ID, variant.CreatedAt, variant.CreatedBy = existing.ID, existing.CreatedAt, existing.CreatedBy
+	}
+	saved, err := uc.Repo.SaveVariant(itm.Name, variant)
+	if err != nil {
+		return nil, false, err
+	}
+	uc.Events.Publish(events.ItemUpdated, saved)
+
+	itm.PriceVariant(variant)
+	return variant, existing == nil, nil
+}
+
+func (uc *ItemUseCase_Impl) DeleteVariant(name string, sku string) error {
+	normalized, err := entities.NormalizeSKU(sku)
+	if err != nil {
+		return err
+	}
+	removed, err := uc.Repo.DeleteVariant(name, normalized, uc.actor(), uc.now())
+	if err != nil {
+		return err
+	}
+	uc.Events.Publish(events.ItemUpdated, removed)
+	return nil
+}
@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type StockUseCase_Impl struct {
+	Repo  repositories.StockRepository
+	Items repositories.ItemRepository
+	Now   func() time.Time
+	Actor string
+}
+
+func NewStockUseCase(repo repositories.StockRepository, items repositories.ItemRepository) *StockUseCase_Impl {
+	return &StockUseCase_Impl{Repo: repo, Items: items, Now: time.Now}
+}
+
+func (uc *StockUseCase_Impl) WithActor(actor string) StockUseCase {
+	scoped := *uc
+	scoped.Actor = actor
+	return &scoped
+}
+
+func (uc *StockUseCase_Impl) GetStock(name string) (*entities.Stock, error) {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.GetStock(item.ID, uc.now())
+}
+
+func (uc *StockUseCase_Impl) Receive(name string, adjustment entities.StockAdjustment) (*entities.Stock, error) {
+	return uc.adjust(name, entities.MovementReceive, adjustment, 1)
+}
+
+func (uc *StockUseCase_Impl) Ship(name string, adjustment entities.StockAdjustment) (*entities.Stock, error) {
+	return uc.adjust(name, entities.MovementShip, adjustment, -1)
+}
+
+func (uc *StockUseCase_Impl) Correct(name string, correction entities.StockCorrection) (*entities.Stock, error) {
+	if err := correction.Validate(); err != nil {
+		return nil, err
+	}
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.CorrectStock(item.ID, correction.OnHand, correction.Reason, uc.actor(), uc.now())
+}
+
+func (uc *StockUseCase_Impl) ListMovements(name string, limit int) ([]*entities.StockMovement, error) {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.ListMovements(item.ID, limit)
+}
+
+func (uc *StockUseCase_Impl) Reserve(name string, input entities.ReservationInput) (*entities.Reservation, error) {
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if !item.Sellable() {
+		return nil, fmt.Errorf("item '%s' is %s: %w", item.Name, item.Status, entities.ErrItemNotSellable)
+	}
+	reservation, err := entities.NewReservation(item.ID, input, uc.actor(), uc.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Reserve(reservation); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func (uc *StockUseCase_Impl) GetReservation(id uuid.UUID) (*entities.Reservation, error) {
+	return uc.Repo.GetReservation(id, uc.now())
+}
+
+func (uc *StockUseCase_Impl) ReleaseReservation(id uuid.UUID) error {
+	return uc.Repo.ReleaseReservation(id, uc.now())
+}
+
+func (uc *StockUseCase_Impl) CommitReservation(id uuid.UUID) (*entities.Stock, error) {
+	return uc.Repo.CommitReservation(id, uc.actor(), uc.now())
+}
+
+func (uc *StockUseCase_Impl) adjust(name string, kind entities.MovementKind, adjustment entities.StockAdjustment, sign int) (*entities.Stock, error) {
+	if err := adjustment.Validate(); err != nil {
+		return nil, err
+	}
+	item, err := uc.Items.GetItemByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return uc.Repo.AdjustStock(item.ID, kind, sign*adjustment.Quantity, adjustment.Reason, uc.actor(), uc.now())
+}
+
+func (uc *StockUseCase_Impl) actor() string {
+	if uc.Actor == "" {
+		return AnonymousActor
+	}
+	return uc.Actor
+}
+
+func (uc *StockUseCase_Impl) now() time.Time {
+	if uc.Now == nil {
+		return time.Now().UTC()
+	}
+	return uc.Now().UTC()
+}
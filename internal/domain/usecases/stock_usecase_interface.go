@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type StockUseCase interface {
+	WithActor(actor string) StockUseCase
+	GetStock(name string) (*entities.Stock, error)
+	Receive(name string, adjustment entities.StockAdjustment) (*entities.Stock, error)
+	Ship(name string, adjustment entities.StockAdjustment) (*entities.Stock, error)
+	Correct(name string, correction entities.StockCorrection) (*entities.Stock, error)
+	ListMovements(name string, limit int) ([]*entities.StockMovement, error)
+	Reserve(name string, input entities.ReservationInput) (*entities.Reservation, error)
+	GetReservation(id uuid.UUID) (*entities.Reservation, error)
+	ReleaseReservation(id uuid.UUID) error
+	CommitReservation(id uuid.UUID) (*entities.Stock, error)
+}
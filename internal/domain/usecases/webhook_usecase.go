@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type WebhookUseCase_Impl struct {
+	Repo repositories.WebhookRepository
+}
+
+func NewWebhookUseCase(repo repositories.WebhookRepository) *WebhookUseCase_Impl {
+	return &WebhookUseCase_Impl{Repo: repo}
+}
+
+func (uc *WebhookUseCase_Impl) ListWebhooks() ([]*webhook.Webhook, error) {
+	hooks, err := uc.Repo.ListWebhooks()
+	if err != nil {
+		return nil, err
+	}
+	redacted := make([]*webhook.Webhook, 0, len(hooks))
+	for _, hook := range hooks {
+		redacted = append(redacted, hook.Redacted())
+	}
+	return redacted, nil
+}
+
+func (uc *WebhookUseCase_Impl) GetWebhook(id uuid.UUID) (*webhook.Webhook, error) {
+	hook, err := uc.Repo.GetWebhook(id)
+	if err != nil {
+		return nil, err
+	}
+	return hook.Redacted(), nil
+}
+
+func (uc *WebhookUseCase_Impl) CreateWebhook(input webhook.Input) (*webhook.Webhook, error) {
+	hook, err := webhook.NewWebhook(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.CreateWebhook(hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (uc *WebhookUseCase_Impl) UpdateWebhook(id uuid.UUID, input webhook.Input) (*webhook.Webhook, error) {
+	hook, err := uc.Repo.GetWebhook(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := hook.Apply(input); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.UpdateWebhook(hook); err != nil {
+		return nil, err
+	}
+	return hook.Redacted(), nil
+}
+
+func (uc *WebhookUseCase_Impl) DeleteWebhook(id uuid.UUID) error {
+	return uc.Repo.DeleteWebhook(id)
+}
+
+func (uc *WebhookUseCase_Impl) ListDeliveries(webhookID uuid.UUID, limit int) ([]*webhook.Delivery, error) {
+	if _, err := uc.Repo.GetWebhook(webhookID); err != nil {
+		return nil, err
+	}
+	return uc.Repo.ListDeliveries(webhookID, limit)
+}
+
+func (uc *WebhookUseCase_Impl) ListDeadLetters(limit int) ([]*webhook.Delivery, error) {
+	return uc.Repo.ListDeadLetters(limit)
+}
+
+func (uc *WebhookUseCase_Impl) RetryDelivery(id uuid.UUID) (*webhook.Delivery, error) {
+	return uc.Repo.RequeueDelivery(id, time.Now().UTC())
+}
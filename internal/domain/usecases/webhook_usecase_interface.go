@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+)
+
+type WebhookUseCase interface {
+	ListWebhooks() ([]*webhook.Webhook, error)
+	GetWebhook(id uuid.UUID) (*webhook.Webhook, error)
+	CreateWebhook(input webhook.Input) (*webhook.Webhook, error)
+	UpdateWebhook(id uuid.UUID, input webhook.Input) (*webhook.Webhook, error)
+	DeleteWebhook(id uuid.UUID) error
+	ListDeliveries(webhookID uuid.UUID, limit int) ([]*webhook.Delivery, error)
+	ListDeadLetters(limit int) ([]*webhook.Delivery, error)
+	RetryDelivery(id uuid.UUID) (*webhook.Delivery, error)
+}
@@ -0,0 +1,29 @@
+package blob
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+	"github.com/afornagieri/go_api_template/internal/infra/outbox"
+)
+
+type Cleaner struct {
+	Store Store
+}
+
+func NewCleaner(store Store) *Cleaner {
+	return &Cleaner{Store: store}
+}
+
+func (c *Cleaner) Publish(ctx context.Context, msg outbox.Message) error {
+	if msg.Type != string(events.ItemDeleted) {
+		return nil
+	}
+	itemID, err := uuid.Parse(msg.AggregateID)
+	if err != nil {
+		return nil
+	}
+	return c.Store.DeletePrefix(itemID.String())
+}
@@ -0,0 +1,98 @@
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type FileSystem struct {
+	Root string
+}
+
+func NewFileSystem(root string) (*FileSystem, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %v", err)
+	}
+	return &FileSystem{Root: root}, nil
+}
+
+func (s *FileSystem) Put(key string, r io.Reader) (int64, error) {
+	target, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return 0, fmt.Errorf("failed to create blob directory: %v", err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create blob: %v", err)
+	}
+	written, err := io.Copy(file, r)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), target)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return 0, fmt.Errorf("failed to write blob '%s': %w", key, err)
+	}
+	return written, nil
+}
+
+func (s *FileSystem) Open(key string) (io.ReadSeekCloser, error) {
+	target, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(target)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("blob '%s': %w", key, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob '%s': %v", key, err)
+	}
+	return file, nil
+}
+
+func (s *FileSystem) Delete(key string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(target)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob '%s': %v", key, err)
+	}
+	return nil
+}
+
+func (s *FileSystem) DeletePrefix(prefix string) error {
+	target, err := s.path(prefix)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(target); err != nil {
+		return fmt.Errorf("failed to delete blobs under '%s': %v", prefix, err)
+	}
+	return nil
+}
+
+func (s *FileSystem) path(key string) (string, error) {
+	if key == "" || path.Clean(key) != key || strings.HasPrefix(key, "/") || strings.HasPrefix(key, "..") || strings.Contains(key, `\`) {
+		return "", fmt.Errorf("invalid blob key '%s'", key)
+	}
+	return filepath.Join(s.Root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,15 @@
+package blob
+
+import (
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+type Store interface {
+	Put(key string, r io.Reader) (int64, error)
+	Open(key string) (io.ReadSeekCloser, error)
+	Delete(key string) error
+	DeletePrefix(prefix string) error
+}
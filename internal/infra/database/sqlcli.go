@@ -2,6 +2,8 @@ package database
 
 import (
 	"database/sql"
+	"fmt"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -11,25 +13,264 @@ type SqlCli struct {
 }
 
 func NewSqlCli() (*SqlCli, error) {
-	conn, err := sql.Open("sqlite3", "./items.db")
-	ensureTableExists(conn)
+	conn, err := sql.Open("sqlite3", "./items.db?_busy_timeout=5000&_txlock=immediate")
+	if err != nil {
+		return nil, err
+	}
+	err = ensureTableExists(conn)
+	if err != nil {
+		return nil, err
+	}
+	err = migrate(conn)
 	if err != nil {
 		return nil, err
 	}
 	return &SqlCli{Conn: conn}, nil
 }
 
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS items (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			price REAL NOT NULL,
+			description TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS idempotency_keys (
+			key TEXT PRIMARY KEY,
+			fingerprint TEXT NOT NULL,
+			status_code INTEGER NOT NULL DEFAULT 0,
+			headers TEXT NOT NULL DEFAULT '{}',
+			body BLOB,
+			created_at TIMESTAMP NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS outbox_events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			event_type TEXT NOT NULL,
+			aggregate_id TEXT NOT NULL,
+			payload BLOB NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			next_attempt_at TIMESTAMP NOT NULL,
+			last_error TEXT,
+			delivered_at TIMESTAMP
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (delivered_at, next_attempt_at)`,
+	`CREATE INDEX IF NOT EXISTS idx_outbox_events_aggregate ON outbox_events (aggregate_id, delivered_at, id)`,
+	`CREATE TABLE IF NOT EXISTS outbox_publications (
+			event_id INTEGER NOT NULL,
+			target TEXT NOT NULL,
+			published_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (event_id, target)
+	)`,
+	`CREATE TABLE IF NOT EXISTS webhooks (
+			id TEXT PRIMARY KEY,
+			url TEXT NOT NULL,
+			events TEXT NOT NULL DEFAULT '[]',
+			secret TEXT NOT NULL,
+			active INTEGER NOT NULL DEFAULT 1,
+			created_at TIMESTAMP NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id TEXT PRIMARY KEY,
+			webhook_id TEXT NOT NULL,
+			event_id INTEGER NOT NULL,
+			event_type TEXT NOT NULL,
+			payload BLOB NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			attempts INTEGER NOT NULL DEFAULT 0,
+			response_status INTEGER NOT NULL DEFAULT 0,
+			last_error TEXT NOT NULL DEFAULT '',
+			next_attempt_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			UNIQUE (webhook_id, event_id)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at)`,
+	`CREATE TABLE IF NOT EXISTS collection_versions (
+			name TEXT PRIMARY KEY,
+			version INTEGER NOT NULL DEFAULT 0,
+			updated_at TIMESTAMP NOT NULL
+	)`,
+	`INSERT OR IGNORE INTO collection_versions (name, version, updated_at) VALUES ('items', 0, strftime('%Y-%m-%d %H:%M:%f', 'now'))`,
+	`CREATE TRIGGER IF NOT EXISTS items_version_insert AFTER INSERT ON items BEGIN
+			UPDATE collection_versions SET version = version + 1, updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now') WHERE name = 'items';
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS items_version_update AFTER UPDATE ON items BEGIN
+			UPDATE collection_versions SET version = version + 1, updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now') WHERE name = 'items';
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS items_version_delete AFTER DELETE ON items BEGIN
+			UPDATE collection_versions SET version = version + 1, updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now') WHERE name = 'items';
+	END`,
+	`CREATE TABLE IF NOT EXISTS categories (
+			id TEXT PRIMARY KEY,
+			name TEXT NOT NULL,
+			parent_id TEXT,
+			created_at TIMESTAMP NOT NULL
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_sibling_name ON categories (COALESCE(parent_id, ''), name)`,
+	`CREATE INDEX IF NOT EXISTS idx_categories_parent ON categories (parent_id)`,
+	`CREATE TABLE IF NOT EXISTS item_categories (
+			item_id TEXT NOT NULL,
+			category_id TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (item_id, category_id)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_item_categories_category ON item_categories (category_id)`,
+	`CREATE TABLE IF NOT EXISTS item_tags (
+			item_id TEXT NOT NULL,
+			tag TEXT NOT NULL,
+			PRIMARY KEY (item_id, tag)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_item_tags_tag ON item_tags (tag)`,
+	`CREATE TABLE IF NOT EXISTS item_stock (
+			item_id TEXT PRIMARY KEY,
+			on_hand INTEGER NOT NULL DEFAULT 0 CHECK (on_hand >= 0),
+			updated_at TIMESTAMP NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS stock_movements (
+			id TEXT PRIMARY KEY,
+			item_id TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			quantity INTEGER NOT NULL,
+			on_hand_after INTEGER NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			reservation_id TEXT,
+			created_at TIMESTAMP NOT NULL,
+			created_by TEXT NOT NULL DEFAULT ''
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_stock_movements_item ON stock_movements (item_id, created_at)`,
+	`CREATE TABLE IF NOT EXISTS stock_reservations (
+			id TEXT PRIMARY KEY,
+			item_id TEXT NOT NULL,
+			quantity INTEGER NOT NULL CHECK (quantity > 0),
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			created_by TEXT NOT NULL DEFAULT ''
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_stock_reservations_item ON stock_reservations (item_id, expires_at)`,
+	`CREATE TABLE IF NOT EXISTS item_prices (
+			id TEXT PRIMARY KEY,
+			item_id TEXT NOT NULL,
+			price REAL NOT NULL CHECK (price > 0),
+			effective_from TIMESTAMP NOT NULL,
+			applied_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL,
+			created_by TEXT NOT NULL DEFAULT ''
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_item_prices_item ON item_prices (item_id, effective_from)`,
+	`CREATE INDEX IF NOT EXISTS idx_item_prices_pending ON item_prices (effective_from) WHERE applied_at IS NULL`,
+	`CREATE TABLE IF NOT EXISTS exchange_rates (
+			currency TEXT PRIMARY KEY,
+			rate REAL NOT NULL CHECK (rate > 0),
+			updated_at TIMESTAMP NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS item_currency_prices (
+			item_id TEXT NOT NULL,
+			currency TEXT NOT NULL,
+			price REAL NOT NULL CHECK (price > 0),
+			PRIMARY KEY (item_id, currency)
+	)`,
+	`CREATE TABLE IF NOT EXISTS item_translations (
+			item_id TEXT NOT NULL,
+			locale TEXT NOT NULL,
+			name TEXT NOT NULL,
+			description TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			updated_by TEXT NOT NULL,
+			PRIMARY KEY (item_id, locale)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_item_translations_locale ON item_translations (locale)`,
+	`CREATE TABLE IF NOT EXISTS item_attachments (
+			id TEXT PRIMARY KEY,
+			item_id TEXT NOT NULL,
+			filename TEXT NOT NULL,
+			content_type TEXT NOT NULL,
+			size INTEGER NOT NULL,
+			checksum TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			created_by TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_item_attachments_item ON item_attachments (item_id, created_at)`,
+	`CREATE TABLE IF NOT EXISTS item_types (
+			name TEXT PRIMARY KEY,
+			description TEXT NOT NULL,
+			schema TEXT NOT NULL CHECK (json_valid(schema)),
+			created_at TIMESTAMP NOT NULL,
+			created_by TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			updated_by TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS item_variants (
+			id TEXT PRIMARY KEY,
+			item_id TEXT NOT NULL,
+			sku TEXT NOT NULL UNIQUE,
+			options TEXT NOT NULL CHECK (json_valid(options)),
+			price REAL CHECK (price IS NULL OR price > 0),
+			created_at TIMESTAMP NOT NULL,
+			created_by TEXT NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			updated_by TEXT NOT NULL,
+			UNIQUE (item_id, options)
+	)`,
+	`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			applied_at TIMESTAMP NOT NULL
+	)`,
+}
+
+var migrations = []string{
+	`ALTER TABLE items ADD COLUMN updated_at TIMESTAMP`,
+	`UPDATE items SET updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now') WHERE updated_at IS NULL`,
+	`ALTER TABLE items ADD COLUMN created_at TIMESTAMP`,
+	`UPDATE items SET created_at = updated_at WHERE created_at IS NULL`,
+	`ALTER TABLE items ADD COLUMN created_by TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE items ADD COLUMN updated_by TEXT NOT NULL DEFAULT ''`,
+	`CREATE INDEX IF NOT EXISTS idx_items_created_at ON items (created_at)`,
+	`CREATE INDEX IF NOT EXISTS idx_items_updated_at ON items (updated_at)`,
+	`INSERT INTO item_prices (id, item_id, price, effective_from, applied_at, created_at, created_by)
+		SELECT lower(hex(randomblob(16))), id, price, created_at, created_at, created_at, created_by FROM items`,
+	`ALTER TABLE items ADD COLUMN currency TEXT NOT NULL DEFAULT 'USD'`,
+	`ALTER TABLE items ADD COLUMN type TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE items ADD COLUMN attributes TEXT CHECK (attributes IS NULL OR json_valid(attributes))`,
+	`CREATE INDEX IF NOT EXISTS idx_items_type ON items (type)`,
+	`ALTER TABLE items ADD COLUMN status TEXT NOT NULL DEFAULT 'active'`,
+	`CREATE INDEX IF NOT EXISTS idx_items_status ON items (status)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_items_name ON items (name)`,
+}
+
 func ensureTableExists(db *sql.DB) error {
-	_, err := db.Exec(`
-			CREATE TABLE IF NOT EXISTS items (
-					id TEXT PRIMARY KEY,
-					name TEXT NOT NULL,
-					price REAL NOT NULL,
-					description TEXT NOT NULL
-			)
-	`)
+	for _, stmt := range schema {
+		_, err := db.Exec(stmt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func migrate(db *sql.DB) error {
+	var current int
+	err := db.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&current)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to read schema version: %v", err)
+	}
+
+	for i := current; i < len(migrations); i++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return fmt.Errorf("could not begin migration %d: %v", i+1, err)
+		}
+		_, err = tx.Exec(migrations[i])
+		if err == nil {
+			_, err = tx.Exec("INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)", i+1, time.Now().UTC())
+		}
+		if err == nil {
+			err = tx.Commit()
+		}
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to apply migration %d: %v", i+1, err)
+		}
 	}
 	return nil
 }
@@ -1,14 +1,46 @@
 package di
 
 import (
+	"log"
+	"os"
+	"time"
+
 	controller "github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	"github.com/afornagieri/go_api_template/internal/adapter/grpcserver"
+	"github.com/afornagieri/go_api_template/internal/adapter/httpcache"
+	"github.com/afornagieri/go_api_template/internal/adapter/middlewares"
+	"github.com/afornagieri/go_api_template/internal/adapter/openapi"
 	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/internal/infra/blob"
+	"github.com/afornagieri/go_api_template/internal/infra/cache"
 	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/outbox"
+	"github.com/afornagieri/go_api_template/internal/infra/pricing"
 	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+	"github.com/afornagieri/go_api_template/internal/infra/webhooks"
 )
 
 type Container struct {
-	ItemController *controller.ItemController
+	ItemController       *controller.ItemController
+	ItemServer           *grpcserver.ItemServer
+	ItemCache            *repositories.CachingItemRepository
+	GraphQLController    *controller.GraphQLController
+	EventsController     *controller.EventsController
+	WebSocket            *controller.WebSocketController
+	WebhookController    *controller.WebhookController
+	CategoryController   *controller.CategoryController
+	StockController      *controller.StockController
+	CurrencyController   *controller.CurrencyController
+	AttachmentController *controller.AttachmentController
+	ItemTypeController   *controller.ItemTypeController
+	DocsController       *controller.DocsController
+	Idempotency          *middlewares.Idempotency
+	RequestValidation    *middlewares.RequestValidation
+	CacheControl         *middlewares.CacheControl
+	OpenAPI              *openapi.Document
+	Outbox               *outbox.Dispatcher
+	Webhooks             *webhooks.Deliverer
+	Prices               *pricing.Scheduler
 }
 
 func NewContainer() *Container {
@@ -17,9 +49,172 @@ func NewContainer() *Container {
 		panic(err)
 	}
 
-	itemRepository := repositories.NewItemRepository(db)
+	itemStore := repositories.NewItemRepository(db)
+	itemRepository := repositories.NewCachingItemRepository(itemStore, cache.NewLRU(10000), 5*time.Minute)
 	itemUseCase := usecases.NewItemUseCase(itemRepository)
 	itemController := controller.NewItemController(itemUseCase)
+	itemServer := grpcserver.NewItemServer(itemUseCase, itemUseCase.Events)
+	graphQLController := controller.NewGraphQLController(itemUseCase)
+	eventsController := controller.NewEventsController(itemUseCase.Events)
+	webSocket := controller.NewWebSocketController(itemUseCase.Events)
+
+	idempotencyRepository := repositories.NewIdempotencyRepository(db)
+	idempotency := middlewares.NewIdempotency(idempotencyRepository)
+
+	document := openapi.NewDocument(itemController.Representations.MediaTypes())
+	docsController := controller.NewDocsController(document)
+	requestValidation := middlewares.NewRequestValidation(document, itemController.Representations)
+	cacheControl := middlewares.NewCacheControl(cachePolicies())
+
+	categoryRepository := repositories.NewCategoryRepository(db)
+	categoryController := controller.NewCategoryController(usecases.NewCategoryUseCase(categoryRepository, itemRepository))
+
+	stockController := controller.NewStockController(usecases.NewStockUseCase(itemStore, itemRepository))
+
+	currencyUseCase := usecases.NewCurrencyUseCase(repositories.NewCurrencyRepository(db), itemRepository)
+	loadExchangeRates(currencyUseCase)
+	itemController.Currencies = currencyUseCase
+	currencyController := controller.NewCurrencyController(currencyUseCase)
 
-	return &Container{ItemController: itemController}
+	blobs := newBlobStore()
+	attachmentController := controller.NewAttachmentController(usecases.NewAttachmentUseCase(itemStore, itemRepository, blobs))
+
+	itemTypeRepository := repositories.NewItemTypeRepository(db)
+	itemUseCase.Types = itemTypeRepository
+	itemTypeController := controller.NewItemTypeController(usecases.NewItemTypeUseCase(itemTypeRepository, itemRepository))
+
+	webhookRepository := repositories.NewWebhookRepository(db)
+	webhookController := controller.NewWebhookController(usecases.NewWebhookUseCase(webhookRepository))
+	webhookDeliverer := webhooks.NewDeliverer(webhookRepository)
+	webhookDeliverer.Retention = durationFromEnv("WEBHOOK_DELIVERY_RETENTION", webhookDeliverer.Retention)
+
+	outboxRepository := repositories.NewOutboxRepository(db)
+	outboxPublisher := outbox.NewFanOut(outboxRepository,
+		outbox.Target{Name: "bus", Publisher: newOutboxPublisher()},
+		outbox.Target{Name: "webhooks", Publisher: webhooks.NewEnqueuer(webhookRepository)},
+		outbox.Target{Name: "attachments", Publisher: blob.NewCleaner(blobs)},
+	)
+	outboxDispatcher := outbox.NewDispatcher(outboxRepository, outboxPublisher)
+	outboxDispatcher.Retention = durationFromEnv("OUTBOX_RETENTION", outboxDispatcher.Retention)
+
+	return &Container{
+		ItemController:       itemController,
+		ItemServer:           itemServer,
+		ItemCache:            itemRepository,
+		GraphQLController:    graphQLController,
+		EventsController:     eventsController,
+		WebSocket:            webSocket,
+		WebhookController:    webhookController,
+		CategoryController:   categoryController,
+		StockController:      stockController,
+		CurrencyController:   currencyController,
+		AttachmentController: attachmentController,
+		ItemTypeController:   itemTypeController,
+		DocsController:       docsController,
+		Idempotency:          idempotency,
+		RequestValidation:    requestValidation,
+		CacheControl:         cacheControl,
+		OpenAPI:              document,
+		Outbox:               outboxDispatcher,
+		Webhooks:             webhookDeliverer,
+		Prices:               pricing.NewScheduler(itemUseCase),
+	}
+}
+
+func cachePolicies() map[string]httpcache.Policy {
+	return map[string]httpcache.Policy{
+		"GET /items": {
+			Public:               true,
+			MaxAge:               30 * time.Second,
+			SharedMaxAge:         60 * time.Second,
+			StaleWhileRevalidate: 30 * time.Second,
+		},
+		"GET /items/{name}": {
+			Public:               true,
+			MaxAge:               60 * time.Second,
+			SharedMaxAge:         5 * time.Minute,
+			StaleWhileRevalidate: time.Minute,
+		},
+		"GET /tags": {
+			Public:               true,
+			MaxAge:               30 * time.Second,
+			StaleWhileRevalidate: 30 * time.Second,
+		},
+		"GET /categories":                            {NoCache: true},
+		"GET /categories/{id}":                       {NoCache: true},
+		"GET /categories/{id}/items":                 {NoCache: true},
+		"GET /exchange-rates":                        {NoCache: true},
+		"GET /item-types":                            {NoCache: true},
+		"GET /item-types/{name}":                     {NoCache: true},
+		"GET /items/{name}/attachments":              {NoCache: true},
+		"GET /items/{name}/attachments/{id}":         {NoCache: true},
+		"GET /items/{name}/attachments/{id}/content": {NoCache: true},
+		"GET /items/{name}/currency-prices":          {NoCache: true},
+		"GET /items/{name}/prices":                   {NoCache: true},
+		"GET /items/{name}/prices/{id}":              {NoCache: true},
+		"GET /items/{name}/translations":             {NoCache: true},
+		"GET /items/{name}/translations/{locale}":    {NoCache: true},
+		"GET /items/{name}/variants":                 {NoCache: true},
+		"GET /items/{name}/variants/{sku}":           {NoCache: true},
+		"GET /items/{name}/stock":                    {NoStore: true},
+		"GET /items/{name}/stock/movements":          {NoStore: true},
+		"GET /reservations/{id}":                     {NoStore: true},
+		"GET /webhooks":                              {NoStore: true},
+		"GET /webhooks/{id}":                         {NoStore: true},
+		"GET /webhooks/{id}/deliveries":              {NoStore: true},
+		"GET /webhooks/dead-letters":                 {NoStore: true},
+	}
+}
+
+func newOutboxPublisher() outbox.Publisher {
+	path := os.Getenv("OUTBOX_FILE")
+	if path == "" {
+		return outbox.NewStdoutPublisher()
+	}
+	publisher, err := outbox.NewFilePublisher(path)
+	if err != nil {
+		panic(err)
+	}
+	return publisher
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		panic(err)
+	}
+	return duration
+}
+
+func newBlobStore() blob.Store {
+	root := os.Getenv("ATTACHMENTS_DIR")
+	if root == "" {
+		root = "./attachments"
+	}
+	store, err := blob.NewFileSystem(root)
+	if err != nil {
+		panic(err)
+	}
+	return store
+}
+
+func loadExchangeRates(useCase usecases.CurrencyUseCase) {
+	path := os.Getenv("EXCHANGE_RATES_FILE")
+	if path == "" {
+		return
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+	loaded, err := useCase.LoadRates(file)
+	if err != nil {
+		panic(err)
+	}
+	log.Printf("Loaded %d exchange rates from %s", loaded, path)
 }
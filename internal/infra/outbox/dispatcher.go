@@ -0,0 +1,122 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type Dispatcher struct {
+	Repo          repositories.OutboxRepository
+	Publisher     Publisher
+	BatchSize     int
+	PollInterval  time.Duration
+	Retention     time.Duration
+	PurgeInterval time.Duration
+	Backoff       func(attempts int) time.Duration
+	Now           func() time.Time
+}
+
+func NewDispatcher(repo repositories.OutboxRepository, publisher Publisher) *Dispatcher {
+	return &Dispatcher{
+		Repo:          repo,
+		Publisher:     publisher,
+		BatchSize:     100,
+		PollInterval:  time.Second,
+		Retention:     7 * 24 * time.Hour,
+		PurgeInterval: time.Hour,
+		Backoff:       ExponentialBackoff(time.Second, 5*time.Minute),
+		Now:           time.Now,
+	}
+}
+
+func ExponentialBackoff(base time.Duration, max time.Duration) func(attempts int) time.Duration {
+	return func(attempts int) time.Duration {
+		delay := base
+		for i := 1; i < attempts && delay < max; i++ {
+			delay *= 2
+		}
+		if delay > max {
+			return max
+		}
+		return delay
+	}
+}
+
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+
+	var purgedAt time.Time
+	for {
+		if d.Retention > 0 && d.Now().Sub(purgedAt) >= d.PurgeInterval {
+			if _, err := d.PurgeDelivered(); err != nil {
+				log.Printf("Failed to purge delivered outbox events: %v", err)
+			}
+			purgedAt = d.Now()
+		}
+
+		delivered, err := d.DispatchPending(ctx)
+		if err != nil {
+			log.Printf("Failed to dispatch outbox events: %v", err)
+		}
+		if err == nil && delivered == d.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	records, err := d.Repo.PendingEvents(d.Now(), d.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	blocked := make(map[string]bool)
+	for _, record := range records {
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+		if blocked[record.AggregateID] {
+			continue
+		}
+
+		msg := Message{
+			ID:          record.ID,
+			Type:        record.EventType,
+			AggregateID: record.AggregateID,
+			Payload:     record.Payload,
+			OccurredAt:  record.CreatedAt,
+		}
+		if err := d.Publisher.Publish(ctx, msg); err != nil {
+			blocked[record.AggregateID] = true
+			nextAttempt := d.Now().Add(d.Backoff(record.Attempts + 1))
+			if err := d.Repo.MarkFailed(record.ID, nextAttempt, err.Error()); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+
+		if err := d.Repo.MarkDelivered(record.ID, d.Now()); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+func (d *Dispatcher) PurgeDelivered() (int64, error) {
+	if d.Retention <= 0 {
+		return 0, nil
+	}
+	return d.Repo.PurgeDelivered(d.Now().Add(-d.Retention))
+}
@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+)
+
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []Message
+	failures int
+	err      error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) FailNext(n int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failures = n
+	p.err = err
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failures > 0 {
+		p.failures--
+		return p.err
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Message(nil), p.messages...)
+}
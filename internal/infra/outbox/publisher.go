@@ -0,0 +1,71 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Message struct {
+	ID          int64           `json:"id"`
+	Type        string          `json:"type"`
+	AggregateID string          `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+type Target struct {
+	Name      string
+	Publisher Publisher
+}
+
+type Progress interface {
+	PublishedTargets(eventID int64) ([]string, error)
+	MarkPublished(eventID int64, target string, at time.Time) error
+}
+
+type FanOut struct {
+	Targets  []Target
+	Progress Progress
+	Now      func() time.Time
+}
+
+func NewFanOut(progress Progress, targets ...Target) *FanOut {
+	return &FanOut{Targets: targets, Progress: progress, Now: time.Now}
+}
+
+func (f *FanOut) Publish(ctx context.Context, msg Message) error {
+	published := make(map[string]bool)
+	if f.Progress != nil {
+		names, err := f.Progress.PublishedTargets(msg.ID)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			published[name] = true
+		}
+	}
+
+	var errs []error
+	for _, target := range f.Targets {
+		if published[target.Name] {
+			continue
+		}
+		if err := target.Publisher.Publish(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", target.Name, err))
+			continue
+		}
+		if f.Progress != nil {
+			if err := f.Progress.MarkPublished(msg.ID, target.Name, f.Now()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
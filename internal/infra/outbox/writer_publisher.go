@@ -0,0 +1,60 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+type WriterPublisher struct {
+	mu   sync.Mutex
+	w    io.Writer
+	sync bool
+}
+
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{w: w}
+}
+
+func NewStdoutPublisher() *WriterPublisher {
+	return NewWriterPublisher(os.Stdout)
+}
+
+func NewFilePublisher(path string) (*WriterPublisher, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open outbox file: %v", err)
+	}
+	return &WriterPublisher{w: file, sync: true}, nil
+}
+
+func (p *WriterPublisher) Publish(ctx context.Context, msg Message) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox message: %v", err)
+	}
+	line = append(line, '\n')
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.w.Write(line); err != nil {
+		return fmt.Errorf("failed to write outbox message: %v", err)
+	}
+	if file, ok := p.w.(*os.File); ok && p.sync {
+		if err := file.Sync(); err != nil {
+			return fmt.Errorf("failed to sync outbox file: %v", err)
+		}
+	}
+	return nil
+}
+
+func (p *WriterPublisher) Close() error {
+	if closer, ok := p.w.(io.Closer); ok && p.sync {
+		return closer.Close()
+	}
+	return nil
+}
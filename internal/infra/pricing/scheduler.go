@@ -0,0 +1,44 @@
+package pricing
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+)
+
+type Scheduler struct {
+	UseCase      usecases.ItemUseCase
+	BatchSize    int
+	PollInterval time.Duration
+}
+
+func NewScheduler(useCase usecases.ItemUseCase) *Scheduler {
+	return &Scheduler{
+		UseCase:      useCase,
+		BatchSize:    100,
+		PollInterval: time.Second,
+	}
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		applied, err := s.UseCase.ApplyDuePriceChanges(s.BatchSize)
+		if err != nil {
+			log.Printf("Failed to apply scheduled prices: %v", err)
+		}
+		if err == nil && applied == s.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
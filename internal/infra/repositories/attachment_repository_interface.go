@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type AttachmentRepository interface {
+	ListAttachments(itemID uuid.UUID) ([]*entities.Attachment, error)
+	GetAttachment(itemID uuid.UUID, id uuid.UUID) (*entities.Attachment, error)
+	AddAttachment(itemID uuid.UUID, attachment *entities.Attachment) error
+	DeleteAttachment(itemID uuid.UUID, id uuid.UUID) error
+}
@@ -0,0 +1,296 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	database "github.com/afornagieri/go_api_template/internal/infra/database"
+)
+
+const categoryColumns = "id, name, parent_id, created_at"
+
+const categoryAncestorsQuery = `WITH RECURSIVE ancestors(id) AS (
+		SELECT ?
+		UNION
+		SELECT c.parent_id FROM categories c JOIN ancestors a ON c.id = a.id WHERE c.parent_id IS NOT NULL
+	)
+	SELECT COUNT(*) FROM ancestors WHERE id = ?`
+
+const categoryTreeItemsQuery = `WITH RECURSIVE tree(id) AS (
+		SELECT id FROM categories WHERE id = ?
+		UNION
+		SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+	)
+	SELECT ` + itemSelect + ` FROM items
+	WHERE id IN (SELECT item_id FROM item_categories WHERE category_id IN (SELECT id FROM tree))
+	ORDER BY name`
+
+type CategoryRepository_Impl struct {
+	DB *database.SqlCli
+}
+
+func NewCategoryRepository(db *database.SqlCli) *CategoryRepository_Impl {
+	return &CategoryRepository_Impl{DB: db}
+}
+
+func (repo *CategoryRepository_Impl) ListCategories() ([]*category.Category, error) {
+	var categories []*category.Category
+
+	rows, err := repo.DB.Conn.Query("SELECT " + categoryColumns + " FROM categories ORDER BY name, id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch categories: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+
+	return categories, rows.Err()
+}
+
+func (repo *CategoryRepository_Impl) GetCategory(id uuid.UUID) (*category.Category, error) {
+	c, err := scanCategory(repo.DB.Conn.QueryRow("SELECT "+categoryColumns+" FROM categories WHERE id = ?", id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("category '%s' not found: %w", id, category.ErrCategoryNotFound)
+	}
+	return c, err
+}
+
+func (repo *CategoryRepository_Impl) CreateCategory(c *category.Category) error {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	err = checkCategoryPlacement(tx, c)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO categories (id, name, parent_id, created_at) VALUES (?, ?, ?, ?)",
+		c.ID.String(), c.Name, nullableID(c.ParentID), c.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to insert category: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit category: %v", err)
+	}
+	return nil
+}
+
+func (repo *CategoryRepository_Impl) UpdateCategory(c *category.Category) error {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	err = checkCategoryPlacement(tx, c)
+	if err != nil {
+		return err
+	}
+
+	if c.ParentID != nil {
+		var cycles int
+		err = tx.QueryRow(categoryAncestorsQuery, c.ParentID.String(), c.ID.String()).Scan(&cycles)
+		if err != nil {
+			return fmt.Errorf("failed to check category ancestry: %v", err)
+		}
+		if cycles > 0 {
+			err = fmt.Errorf("category '%s' cannot be moved below its descendant '%s': %w", c.ID, c.ParentID, category.ErrCategoryCycle)
+			return err
+		}
+	}
+
+	res, err := tx.Exec("UPDATE categories SET name = ?, parent_id = ? WHERE id = ?", c.Name, nullableID(c.ParentID), c.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to update category: %v", err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update category: %v", err)
+	}
+	if updated == 0 {
+		err = fmt.Errorf("category '%s' not found: %w", c.ID, category.ErrCategoryNotFound)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit category update: %v", err)
+	}
+	return nil
+}
+
+func (repo *CategoryRepository_Impl) DeleteCategory(id uuid.UUID) error {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var children, items int
+	err = tx.QueryRow(`SELECT
+		(SELECT COUNT(*) FROM categories WHERE parent_id = ?),
+		(SELECT COUNT(*) FROM item_categories WHERE category_id = ?)`, id.String(), id.String()).Scan(&children, &items)
+	if err != nil {
+		return fmt.Errorf("failed to check category contents: %v", err)
+	}
+	if children > 0 || items > 0 {
+		err = fmt.Errorf("category '%s' has %d subcategories and %d items: %w", id, children, items, category.ErrCategoryNotEmpty)
+		return err
+	}
+
+	res, err := tx.Exec("DELETE FROM categories WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %v", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %v", err)
+	}
+	if deleted == 0 {
+		err = fmt.Errorf("category '%s' not found: %w", id, category.ErrCategoryNotFound)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit category delete: %v", err)
+	}
+	return nil
+}
+
+func (repo *CategoryRepository_Impl) ListItems(id uuid.UUID, includeDescendants bool) ([]*entities.Item, error) {
+	if _, err := repo.GetCategory(id); err != nil {
+		return nil, err
+	}
+
+	query := "SELECT " + itemSelect + " FROM items WHERE id IN (SELECT item_id FROM item_categories WHERE category_id = ?) ORDER BY name"
+	if includeDescendants {
+		query = categoryTreeItemsQuery
+	}
+
+	rows, err := repo.DB.Conn.Query(query, id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch category items: %v", err)
+	}
+	defer rows.Close()
+
+	items := []*entities.Item{}
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+func (repo *CategoryRepository_Impl) AddItem(id uuid.UUID, itemID uuid.UUID) error {
+	if _, err := repo.GetCategory(id); err != nil {
+		return err
+	}
+
+	_, err := repo.DB.Conn.Exec("INSERT INTO item_categories (item_id, category_id, created_at) VALUES (?, ?, ?) ON CONFLICT(item_id, category_id) DO NOTHING",
+		itemID.String(), id.String(), time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to assign item to category: %v", err)
+	}
+	return nil
+}
+
+func (repo *CategoryRepository_Impl) RemoveItem(id uuid.UUID, itemID uuid.UUID) error {
+	res, err := repo.DB.Conn.Exec("DELETE FROM item_categories WHERE item_id = ? AND category_id = ?", itemID.String(), id.String())
+	if err != nil {
+		return fmt.Errorf("failed to remove item from category: %v", err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to remove item from category: %v", err)
+	}
+	if removed == 0 {
+		return fmt.Errorf("item '%s' is not in category '%s': %w", itemID, id, category.ErrAssignmentNotFound)
+	}
+	return nil
+}
+
+func checkCategoryPlacement(tx *sql.Tx, c *category.Category) error {
+	if c.ParentID != nil {
+		var parents int
+		err := tx.QueryRow("SELECT COUNT(*) FROM categories WHERE id = ?", c.ParentID.String()).Scan(&parents)
+		if err != nil {
+			return fmt.Errorf("failed to check parent category: %v", err)
+		}
+		if parents == 0 {
+			return &category.ValidationError{Field: "parent_id", Message: fmt.Sprintf("parent category '%s' does not exist", c.ParentID)}
+		}
+	}
+
+	var siblings int
+	err := tx.QueryRow("SELECT COUNT(*) FROM categories WHERE COALESCE(parent_id, '') = ? AND name = ? AND id != ?",
+		nullableID(c.ParentID).String, c.Name, c.ID.String()).Scan(&siblings)
+	if err != nil {
+		return fmt.Errorf("failed to check sibling categories: %v", err)
+	}
+	if siblings > 0 {
+		return fmt.Errorf("category '%s' already exists at this level: %w", c.Name, category.ErrCategoryAlreadyExists)
+	}
+	return nil
+}
+
+func scanCategory(row rowScanner) (*category.Category, error) {
+	var c category.Category
+	var parentID sql.NullString
+
+	err := row.Scan(&c.ID, &c.Name, &parentID, &c.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan category row: %v", err)
+	}
+	if parentID.Valid {
+		id, err := uuid.Parse(parentID.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse parent category id: %v", err)
+		}
+		c.ParentID = &id
+	}
+	return &c, nil
+}
+
+func nullableID(id *uuid.UUID) sql.NullString {
+	if id == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: id.String(), Valid: true}
+}
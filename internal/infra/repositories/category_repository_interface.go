@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type CategoryRepository interface {
+	ListCategories() ([]*category.Category, error)
+	GetCategory(id uuid.UUID) (*category.Category, error)
+	CreateCategory(c *category.Category) error
+	UpdateCategory(c *category.Category) error
+	DeleteCategory(id uuid.UUID) error
+	ListItems(id uuid.UUID, includeDescendants bool) ([]*entities.Item, error)
+	AddItem(id uuid.UUID, itemID uuid.UUID) error
+	RemoveItem(id uuid.UUID, itemID uuid.UUID) error
+}
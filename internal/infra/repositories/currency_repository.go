@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	database "github.com/afornagieri/go_api_template/internal/infra/database"
+)
+
+const exchangeRateColumns = "currency, rate, updated_at"
+
+const upsertExchangeRate = `INSERT INTO exchange_rates (currency, rate, updated_at) VALUES (?, ?, ?)
+	ON CONFLICT(currency) DO UPDATE SET rate = excluded.rate, updated_at = excluded.updated_at`
+
+type CurrencyRepository_Impl struct {
+	DB *database.SqlCli
+}
+
+func NewCurrencyRepository(db *database.SqlCli) *CurrencyRepository_Impl {
+	return &CurrencyRepository_Impl{DB: db}
+}
+
+func (repo *CurrencyRepository_Impl) ListRates() ([]*entities.ExchangeRate, error) {
+	rows, err := repo.DB.Conn.Query("SELECT " + exchangeRateColumns + " FROM exchange_rates ORDER BY currency")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch exchange rates: %v", err)
+	}
+	defer rows.Close()
+
+	rates := []*entities.ExchangeRate{}
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, rows.Err()
+}
+
+func (repo *CurrencyRepository_Impl) GetRate(currency string) (*entities.ExchangeRate, error) {
+	rate, err := scanExchangeRate(repo.DB.Conn.QueryRow("SELECT "+exchangeRateColumns+" FROM exchange_rates WHERE currency = ?", currency))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("no exchange rate for %s: %w", currency, entities.ErrExchangeRateNotFound)
+	}
+	return rate, err
+}
+
+func (repo *CurrencyRepository_Impl) SetRate(rate *entities.ExchangeRate) error {
+	_, err := repo.DB.Conn.Exec(upsertExchangeRate, rate.Currency, rate.Rate, rate.UpdatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to save exchange rate: %v", err)
+	}
+	return nil
+}
+
+func (repo *CurrencyRepository_Impl) SetRates(rates []*entities.ExchangeRate) error {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	for _, rate := range rates {
+		_, err = tx.Exec(upsertExchangeRate, rate.Currency, rate.Rate, rate.UpdatedAt.UTC())
+		if err != nil {
+			return fmt.Errorf("failed to save exchange rate: %v", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit exchange rates: %v", err)
+	}
+	return nil
+}
+
+func (repo *CurrencyRepository_Impl) DeleteRate(currency string) error {
+	res, err := repo.DB.Conn.Exec("DELETE FROM exchange_rates WHERE currency = ?", currency)
+	if err != nil {
+		return fmt.Errorf("failed to delete exchange rate: %v", err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete exchange rate: %v", err)
+	}
+	if removed == 0 {
+		return fmt.Errorf("no exchange rate for %s: %w", currency, entities.ErrExchangeRateNotFound)
+	}
+	return nil
+}
+
+func (repo *CurrencyRepository_Impl) ListItemPrices(itemID uuid.UUID) ([]*entities.CurrencyPrice, error) {
+	rows, err := repo.DB.Conn.Query("SELECT currency, price FROM item_currency_prices WHERE item_id = ? ORDER BY currency", itemID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch item currency prices: %v", err)
+	}
+	defer rows.Close()
+
+	prices := []*entities.CurrencyPrice{}
+	for rows.Next() {
+		var price entities.CurrencyPrice
+		if err := rows.Scan(&price.Currency, &price.Price); err != nil {
+			return nil, fmt.Errorf("failed to scan item currency price row: %v", err)
+		}
+		prices = append(prices, &price)
+	}
+
+	return prices, rows.Err()
+}
+
+func (repo *CurrencyRepository_Impl) PricesIn(currency string) (map[uuid.UUID]float64, error) {
+	rows, err := repo.DB.Conn.Query("SELECT item_id, price FROM item_currency_prices WHERE currency = ?", currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch item currency prices: %v", err)
+	}
+	defer rows.Close()
+
+	prices := map[uuid.UUID]float64{}
+	for rows.Next() {
+		var itemID uuid.UUID
+		var price float64
+		if err := rows.Scan(&itemID, &price); err != nil {
+			return nil, fmt.Errorf("failed to scan item currency price row: %v", err)
+		}
+		prices[itemID] = price
+	}
+
+	return prices, rows.Err()
+}
+
+func (repo *CurrencyRepository_Impl) SetItemPrice(itemID uuid.UUID, price *entities.CurrencyPrice) error {
+	_, err := repo.DB.Conn.Exec(`INSERT INTO item_currency_prices (item_id, currency, price) VALUES (?, ?, ?)
+		ON CONFLICT(item_id, currency) DO UPDATE SET price = excluded.price`,
+		itemID.String(), price.Currency, price.Price)
+	if err != nil {
+		return fmt.Errorf("failed to save item currency price: %v", err)
+	}
+	return nil
+}
+
+func (repo *CurrencyRepository_Impl) DeleteItemPrice(itemID uuid.UUID, currency string) error {
+	res, err := repo.DB.Conn.Exec("DELETE FROM item_currency_prices WHERE item_id = ? AND currency = ?", itemID.String(), currency)
+	if err != nil {
+		return fmt.Errorf("failed to delete item currency price: %v", err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete item currency price: %v", err)
+	}
+	if removed == 0 {
+		return fmt.Errorf("item '%s' has no %s price: %w", itemID, currency, entities.ErrCurrencyPriceNotFound)
+	}
+	return nil
+}
+
+func scanExchangeRate(row rowScanner) (*entities.ExchangeRate, error) {
+	var rate entities.ExchangeRate
+	err := row.Scan(&rate.Currency, &rate.Rate, &rate.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan exchange rate row: %v", err)
+	}
+	return &rate, nil
+}
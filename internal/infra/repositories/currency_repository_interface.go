@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type CurrencyRepository interface {
+	ListRates() ([]*entities.ExchangeRate, error)
+	GetRate(currency string) (*entities.ExchangeRate, error)
+	SetRate(rate *entities.ExchangeRate) error
+	SetRates(rates []*entities.ExchangeRate) error
+	DeleteRate(currency string) error
+	ListItemPrices(itemID uuid.UUID) ([]*entities.CurrencyPrice, error)
+	PricesIn(currency string) (map[uuid.UUID]float64, error)
+	SetItemPrice(itemID uuid.UUID, price *entities.CurrencyPrice) error
+	DeleteItemPrice(itemID uuid.UUID, currency string) error
+}
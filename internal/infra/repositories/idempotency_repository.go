@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	database "github.com/afornagieri/go_api_template/internal/infra/database"
+)
+
+type IdempotencyRepository_Impl struct {
+	DB *database.SqlCli
+}
+
+func NewIdempotencyRepository(db *database.SqlCli) *IdempotencyRepository_Impl {
+	return &IdempotencyRepository_Impl{DB: db}
+}
+
+func (repo *IdempotencyRepository_Impl) ReserveKey(key string, fingerprint string, expiredBefore time.Time) (*IdempotencyRecord, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("DELETE FROM idempotency_keys WHERE key = ? AND created_at < ?", key, expiredBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire idempotency key: %v", err)
+	}
+
+	res, err := tx.Exec("INSERT INTO idempotency_keys (key, fingerprint, created_at) VALUES (?, ?, ?) ON CONFLICT(key) DO NOTHING", key, fingerprint, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %v", err)
+	}
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %v", err)
+	}
+
+	var record *IdempotencyRecord
+	if inserted == 0 {
+		record = &IdempotencyRecord{}
+		var headers string
+		err = tx.QueryRow("SELECT key, fingerprint, status_code, headers, body, created_at FROM idempotency_keys WHERE key = ?", key).
+			Scan(&record.Key, &record.Fingerprint, &record.StatusCode, &headers, &record.Body, &record.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get idempotency key '%s': %v", key, err)
+		}
+		if err = json.Unmarshal([]byte(headers), &record.Headers); err != nil {
+			return nil, fmt.Errorf("failed to decode stored headers: %v", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit idempotency key: %v", err)
+	}
+	return record, nil
+}
+
+func (repo *IdempotencyRepository_Impl) SaveResponse(key string, statusCode int, headers map[string]string, body []byte) error {
+	encoded, err := json.Marshal(headers)
+	if err != nil {
+		return fmt.Errorf("failed to encode headers: %v", err)
+	}
+
+	_, err = repo.DB.Conn.Exec("UPDATE idempotency_keys SET status_code = ?, headers = ?, body = ? WHERE key = ?", statusCode, string(encoded), body, key)
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %v", err)
+	}
+	return nil
+}
+
+func (repo *IdempotencyRepository_Impl) ReleaseKey(key string) error {
+	_, err := repo.DB.Conn.Exec("DELETE FROM idempotency_keys WHERE key = ? AND status_code = 0", key)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %v", err)
+	}
+	return nil
+}
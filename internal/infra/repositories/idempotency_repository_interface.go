@@ -0,0 +1,22 @@
+package repositories
+
+import "time"
+
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint string
+	StatusCode  int
+	Headers     map[string]string
+	Body        []byte
+	CreatedAt   time.Time
+}
+
+func (rec *IdempotencyRecord) Completed() bool {
+	return rec.StatusCode != 0
+}
+
+type IdempotencyRepository interface {
+	ReserveKey(key string, fingerprint string, expiredBefore time.Time) (*IdempotencyRecord, error)
+	SaveResponse(key string, statusCode int, headers map[string]string, body []byte) error
+	ReleaseKey(key string) error
+}
@@ -2,12 +2,17 @@ package repositories
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
 	database "github.com/afornagieri/go_api_template/internal/infra/database"
 )
 
@@ -22,48 +27,189 @@ func NewItemRepository(db *database.SqlCli) *ItemRepository_Impl {
 func (repo *ItemRepository_Impl) GetItems() ([]*entities.Item, error) {
 	var items []*entities.Item
 
-	rows, err := repo.DB.Conn.Query("SELECT id, name, price, description FROM items")
+	rows, err := repo.DB.Conn.Query("SELECT " + itemSelect + " FROM items")
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch items: %v", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var item entities.Item
-		var id string
-
-		err := rows.Scan(&id, &item.Name, &item.Price, &item.Description)
+		item, err := scanItem(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan item row: %v", err)
 		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (repo *ItemRepository_Impl) ListItems(query entities.ItemQuery) ([]*entities.Item, error) {
+	var items []*entities.Item
+
+	sqlQuery := "SELECT " + itemSelect + " FROM items WHERE name > ?"
+	args := []any{query.AfterName}
+	if query.NameContains != "" {
+		sqlQuery += " AND instr(lower(name), lower(?)) > 0"
+		args = append(args, query.NameContains)
+	}
+	if query.MinPrice != nil {
+		sqlQuery += " AND price >= ?"
+		args = append(args, *query.MinPrice)
+	}
+	if query.MaxPrice != nil {
+		sqlQuery += " AND price <= ?"
+		args = append(args, *query.MaxPrice)
+	}
+	if query.CreatedAfter != nil {
+		sqlQuery += " AND created_at > ?"
+		args = append(args, query.CreatedAfter.UTC())
+	}
+	if query.CreatedBefore != nil {
+		sqlQuery += " AND created_at < ?"
+		args = append(args, query.CreatedBefore.UTC())
+	}
+	if query.UpdatedAfter != nil {
+		sqlQuery += " AND updated_at > ?"
+		args = append(args, query.UpdatedAfter.UTC())
+	}
+	if query.UpdatedBefore != nil {
+		sqlQuery += " AND updated_at < ?"
+		args = append(args, query.UpdatedBefore.UTC())
+	}
+	if query.CreatedBy != "" {
+		sqlQuery += " AND created_by = ?"
+		args = append(args, query.CreatedBy)
+	}
+	if query.UpdatedBy != "" {
+		sqlQuery += " AND updated_by = ?"
+		args = append(args, query.UpdatedBy)
+	}
+	if len(query.Tags) > 0 {
+		sqlQuery += " AND id IN (SELECT item_id FROM item_tags WHERE tag IN (?" + strings.Repeat(", ?", len(query.Tags)-1) + ")"
+		for _, tag := range query.Tags {
+			args = append(args, tag)
+		}
+		if !query.MatchAnyTag {
+			sqlQuery += " GROUP BY item_id HAVING COUNT(*) = ?"
+			args = append(args, len(query.Tags))
+		}
+		sqlQuery += ")"
+	}
+	if len(query.Statuses) > 0 {
+		sqlQuery += " AND status IN (?" + strings.Repeat(", ?", len(query.Statuses)-1) + ")"
+		for _, status := range query.Statuses {
+			args = append(args, status)
+		}
+	}
+	if query.Type != "" {
+		sqlQuery += " AND type = ?"
+		args = append(args, query.Type)
+	}
+	for _, filter := range query.Attributes {
+		path := "$." + filter.Name
+		if filter.Operator == entities.AttributeEquals {
+			sqlQuery += " AND json_extract(attributes, ?) IN (?, ?)"
+			args = append(args, path, filter.Value, filter.Raw)
+			continue
+		}
+		sqlQuery += " AND json_type(attributes, ?) IN ('integer', 'real') AND json_extract(attributes, ?) " + attributeComparisons[filter.Operator] + " ?"
+		args = append(args, path, path, filter.Value)
+	}
+	sqlQuery += " ORDER BY " + orderBy(query)
+	if query.Limit > 0 {
+		sqlQuery += " LIMIT ?"
+		args = append(args, query.Limit)
+	}
 
-		item.ID, _ = uuid.Parse(id)
-		items = append(items, &item)
+	rows, err := repo.DB.Conn.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item row: %v", err)
+		}
+		items = append(items, item)
 	}
 
 	return items, nil
 }
 
-func (repo *ItemRepository_Impl) GetItemByName(name string) (*entities.Item, error) {
-	var item entities.Item
+func (repo *ItemRepository_Impl) StreamItems(statuses []string, fn func(item *entities.Item) error) error {
+	sqlQuery := "SELECT " + itemSelect + " FROM items"
+	var args []any
+	if len(statuses) > 0 {
+		sqlQuery += " WHERE status IN (?" + strings.Repeat(", ?", len(statuses)-1) + ")"
+		for _, status := range statuses {
+			args = append(args, status)
+		}
+	}
 
-	err := repo.DB.Conn.QueryRow("SELECT id, name, price, description FROM items WHERE name = ?", name).
-		Scan(&item.ID, &item.Name, &item.Price, &item.Description)
+	rows, err := repo.DB.Conn.Query(sqlQuery, args...)
+	if err != nil {
+		return fmt.Errorf("failed to fetch items: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan item row: %v", err)
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (repo *ItemRepository_Impl) GetItemByName(name string) (*entities.Item, error) {
+	item, err := scanItem(repo.DB.Conn.QueryRow("SELECT "+itemSelect+" FROM items WHERE name = ?", name))
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("item '%s' not found: %v", name, err)
+			return nil, fmt.Errorf("item '%s' not found: %w", name, notFoundError{err})
 		}
 		return nil, fmt.Errorf("failed to get item by name: %v", err)
 	}
 
-	return &item, nil
+	return item, nil
 }
 
-func (repo *ItemRepository_Impl) CreateItem(item *entities.Item) error {
+func (repo *ItemRepository_Impl) GetItemByID(id uuid.UUID) (*entities.Item, error) {
+	item, err := scanItem(repo.DB.Conn.QueryRow("SELECT "+itemSelect+" FROM items WHERE id = ?", id.String()))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("item '%s' not found: %w", id, notFoundError{err})
+		}
+		return nil, fmt.Errorf("failed to get item by id: %v", err)
+	}
+
+	return item, nil
+}
+
+func (repo *ItemRepository_Impl) GetCollectionVersion() (*entities.CollectionVersion, error) {
+	var version entities.CollectionVersion
+
+	err := repo.DB.Conn.QueryRow("SELECT version, updated_at FROM collection_versions WHERE name = 'items'").
+		Scan(&version.Version, &version.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items version: %v", err)
+	}
+
+	return &version, nil
+}
+
+func (repo *ItemRepository_Impl) CreateItem(item *entities.Item) (*entities.Item, error) {
 	tx, err := repo.DB.Conn.Begin()
 	if err != nil {
-		return fmt.Errorf("could not begin transaction: %v", err)
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
 	}
 	defer func() {
 		if err != nil {
@@ -73,19 +219,48 @@ func (repo *ItemRepository_Impl) CreateItem(item *entities.Item) error {
 
 	newItem, err := entities.NewItem(item.Name, item.Price, item.Description)
 	if err != nil {
-		return fmt.Errorf("failed to create new item: %v", err)
+		return nil, fmt.Errorf("failed to create new item: %w", err)
+	}
+
+	newItem.CreatedAt, newItem.CreatedBy = item.CreatedAt, item.CreatedBy
+	newItem.UpdatedAt, newItem.UpdatedBy = item.UpdatedAt, item.UpdatedBy
+	if item.Currency != "" {
+		newItem.Currency = item.Currency
+	}
+	if item.Status != "" {
+		newItem.Status = item.Status
+	}
+	newItem.Type, newItem.Attributes = item.Type, item.Attributes
+
+	_, err = tx.Exec("INSERT INTO items ("+itemColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		newItem.ID.String(), newItem.Name, newItem.Price, newItem.Description,
+		newItem.CreatedAt.UTC(), newItem.CreatedBy, newItem.UpdatedAt.UTC(), newItem.UpdatedBy, newItem.Currency,
+		newItem.Type, nullableJSON(newItem.Attributes), newItem.Status)
+	if isDuplicateName(err) {
+		return nil, fmt.Errorf("item '%s' already exists: %w", newItem.Name, entities.ErrItemAlreadyExists)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert item: %v", err)
+	}
+
+	err = insertPriceChange(tx, entities.NewAppliedPriceChange(newItem.ID, newItem.Price, newItem.CreatedBy, newItem.CreatedAt))
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = tx.Exec("INSERT INTO items (id, name, price, description) VALUES (?, ?, ?, ?)", newItem.ID.String(), newItem.Name, newItem.Price, newItem.Description)
+	err = insertOutboxEvent(tx, events.ItemCreated, newItem)
 	if err != nil {
-		return fmt.Errorf("failed to insert item: %v", err)
+		return nil, err
 	}
 
 	err = tx.Commit()
-	return nil
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit item: %v", err)
+	}
+	return newItem, nil
 }
 
-func (repo *ItemRepository_Impl) UpdateItem(name string, item *entities.Item) error {
+func (repo *ItemRepository_Impl) ImportItems(items []*entities.Item) error {
 	tx, err := repo.DB.Conn.Begin()
 	if err != nil {
 		return fmt.Errorf("could not begin transaction: %v", err)
@@ -96,20 +271,102 @@ func (repo *ItemRepository_Impl) UpdateItem(name string, item *entities.Item) er
 		}
 	}()
 
-	_, err = repo.getItemByNameInTx(tx, name)
+	stmt, err := tx.Prepare("INSERT INTO items (" + itemColumns + ") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 	if err != nil {
-		return fmt.Errorf("failed to get item '%s': %v", name, err)
+		return fmt.Errorf("failed to prepare item insert: %v", err)
 	}
+	defer stmt.Close()
 
-	_, err = tx.Exec("UPDATE items SET name = ?, price = ?, description = ? WHERE name = ?", item.Name, item.Price, item.Description, name)
-	if err != nil {
-		return fmt.Errorf("failed to update item: %v", err)
+	for _, item := range items {
+		_, err = stmt.Exec(item.ID.String(), item.Name, item.Price, item.Description,
+			item.CreatedAt.UTC(), item.CreatedBy, item.UpdatedAt.UTC(), item.UpdatedBy, item.Currency,
+			item.Type, nullableJSON(item.Attributes), item.Status)
+		if isDuplicateName(err) {
+			return fmt.Errorf("item '%s' already exists: %w", item.Name, entities.ErrItemAlreadyExists)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to insert item '%s': %v", item.Name, err)
+		}
+		err = insertPriceChange(tx, entities.NewAppliedPriceChange(item.ID, item.Price, item.CreatedBy, item.CreatedAt))
+		if err != nil {
+			return err
+		}
+		err = insertOutboxEvent(tx, events.ItemCreated, item)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit import: %v", err)
+	}
 	return nil
 }
 
+func (repo *ItemRepository_Impl) UpdateItem(name string, item *entities.Item) (*entities.Item, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item '%s': %w", name, err)
+	}
+
+	updated := &entities.Item{
+		ID:          existing.ID,
+		Name:        item.Name,
+		Price:       item.Price,
+		Currency:    item.Currency,
+		Description: item.Description,
+		Status:      existing.Status,
+		Type:        item.Type,
+		Attributes:  item.Attributes,
+		CreatedAt:   existing.CreatedAt,
+		CreatedBy:   existing.CreatedBy,
+		UpdatedAt:   item.UpdatedAt,
+		UpdatedBy:   item.UpdatedBy,
+		Tags:        existing.Tags,
+	}
+	if updated.Currency == "" {
+		updated.Currency = existing.Currency
+	}
+	_, err = tx.Exec("UPDATE items SET name = ?, price = ?, currency = ?, description = ?, type = ?, attributes = ?, updated_at = ?, updated_by = ? WHERE name = ?",
+		updated.Name, updated.Price, updated.Currency, updated.Description, updated.Type, nullableJSON(updated.Attributes),
+		updated.UpdatedAt.UTC(), updated.UpdatedBy, name)
+	if isDuplicateName(err) {
+		return nil, fmt.Errorf("cannot rename item '%s': item '%s' already exists: %w", name, updated.Name, entities.ErrItemAlreadyExists)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update item: %v", err)
+	}
+
+	if updated.Price != existing.Price || updated.Currency != existing.Currency {
+		err = insertPriceChange(tx, entities.NewAppliedPriceChange(updated.ID, updated.Price, updated.UpdatedBy, updated.UpdatedAt))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = insertOutboxEvent(tx, events.ItemUpdated, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit item: %v", err)
+	}
+	return updated, nil
+}
+
 func (repo *ItemRepository_Impl) DeleteItem(name string) error {
 	tx, err := repo.DB.Conn.Begin()
 	if err != nil {
@@ -121,24 +378,350 @@ func (repo *ItemRepository_Impl) DeleteItem(name string) error {
 		}
 	}()
 
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete item '%s': %w", name, err)
+	}
+
 	_, err = tx.Exec("DELETE FROM items WHERE name = ?", name)
 	if err != nil {
 		return fmt.Errorf("failed to delete item: %v", err)
 	}
 
+	_, err = tx.Exec("DELETE FROM item_categories WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item categories: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM item_tags WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item tags: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM stock_reservations WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item reservations: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM item_stock WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item stock: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM item_prices WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item prices: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM item_currency_prices WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item currency prices: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM item_translations WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item translations: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM item_attachments WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item attachments: %v", err)
+	}
+
+	_, err = tx.Exec("DELETE FROM item_variants WHERE item_id = ?", existing.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete item variants: %v", err)
+	}
+
+	err = insertOutboxEvent(tx, events.ItemDeleted, existing)
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit delete: %v", err)
+	}
 	return nil
 }
 
-func (repo *ItemRepository_Impl) getItemByNameInTx(tx *sql.Tx, name string) (*entities.Item, error) {
-	var item entities.Item
+func (repo *ItemRepository_Impl) SetItemStatus(name string, from string, to string, actor string, at time.Time) (*entities.Item, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to change status of item '%s': %w", name, err)
+	}
+
+	res, err := tx.Exec("UPDATE items SET status = ? WHERE id = ? AND status = ?", to, existing.ID.String(), from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to change item status: %v", err)
+	}
+	changed, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to change item status: %v", err)
+	}
+	if changed == 0 {
+		err = fmt.Errorf("item '%s' is no longer %s: %w", name, from, entities.ErrInvalidTransition)
+		return nil, err
+	}
+	existing.Status = to
+
+	err = repo.touchItemInTx(tx, existing, actor, at)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit status change: %v", err)
+	}
+	return existing, nil
+}
+
+func (repo *ItemRepository_Impl) AddTag(name string, tag string, actor string, at time.Time) (*entities.Item, bool, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, false, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to tag item '%s': %w", name, err)
+	}
+
+	res, err := tx.Exec("INSERT INTO item_tags (item_id, tag) VALUES (?, ?) ON CONFLICT(item_id, tag) DO NOTHING", existing.ID.String(), tag)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to tag item: %v", err)
+	}
+	added, err := res.RowsAffected()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to tag item: %v", err)
+	}
+	if added == 0 {
+		tx.Rollback()
+		return existing, false, nil
+	}
+
+	existing.Tags = append(existing.Tags, tag)
+	sort.Strings(existing.Tags)
+	err = repo.touchItemInTx(tx, existing, actor, at)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to commit tag: %v", err)
+	}
+	return existing, true, nil
+}
+
+func (repo *ItemRepository_Impl) RemoveTag(name string, tag string, actor string, at time.Time) (*entities.Item, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to untag item '%s': %w", name, err)
+	}
+
+	res, err := tx.Exec("DELETE FROM item_tags WHERE item_id = ? AND tag = ?", existing.ID.String(), tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to untag item: %v", err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to untag item: %v", err)
+	}
+	if removed == 0 {
+		err = fmt.Errorf("item '%s' is not tagged '%s': %w", name, tag, entities.ErrTagNotFound)
+		return nil, err
+	}
 
-	err := tx.QueryRow("SELECT id, name, price, description FROM items WHERE name = ?", name).
-		Scan(&item.ID, &item.Name, &item.Price, &item.Description)
+	tags := existing.Tags[:0]
+	for _, t := range existing.Tags {
+		if t != tag {
+			tags = append(tags, t)
+		}
+	}
+	existing.Tags = tags
+	err = repo.touchItemInTx(tx, existing, actor, at)
+	if err != nil {
+		return nil, err
+	}
 
+	err = tx.Commit()
 	if err != nil {
+		return nil, fmt.Errorf("failed to commit tag removal: %v", err)
+	}
+	return existing, nil
+}
+
+func (repo *ItemRepository_Impl) ListTags() ([]*entities.TagCount, error) {
+	tags := []*entities.TagCount{}
+
+	rows, err := repo.DB.Conn.Query("SELECT tag, COUNT(*) FROM item_tags GROUP BY tag ORDER BY COUNT(*) DESC, tag")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tags: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag entities.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan tag row: %v", err)
+		}
+		tags = append(tags, &tag)
+	}
+
+	return tags, rows.Err()
+}
+
+func (repo *ItemRepository_Impl) touchItemInTx(tx *sql.Tx, item *entities.Item, actor string, at time.Time) error {
+	item.UpdatedAt, item.UpdatedBy = at, actor
+
+	_, err := tx.Exec("UPDATE items SET updated_at = ?, updated_by = ? WHERE id = ?", item.UpdatedAt.UTC(), item.UpdatedBy, item.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to update item: %v", err)
+	}
+
+	return insertOutboxEvent(tx, events.ItemUpdated, item)
+}
+
+func (repo *ItemRepository_Impl) getItemByNameInTx(tx *sql.Tx, name string) (*entities.Item, error) {
+	item, err := scanItem(tx.QueryRow("SELECT "+itemSelect+" FROM items WHERE name = ?", name))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get item '%s' in transaction: %w", name, notFoundError{err})
+		}
 		return nil, fmt.Errorf("failed to get item '%s' in transaction: %v", name, err)
 	}
 
-	return &item, nil
+	return item, nil
+}
+
+const itemColumns = "id, name, price, description, created_at, created_by, updated_at, updated_by, currency, type, attributes, status"
+
+const itemTagsSelect = "(SELECT group_concat(tag, ',') FROM item_tags WHERE item_tags.item_id = items.id)"
+
+const itemSelect = itemColumns + ", " + itemTagsSelect
+
+var sortColumns = map[string]string{
+	entities.SortByName:      "name",
+	entities.SortByPrice:     "price",
+	entities.SortByCreatedAt: "created_at",
+	entities.SortByUpdatedAt: "updated_at",
+}
+
+var attributeComparisons = map[string]string{
+	entities.AttributeGreaterThan:    ">",
+	entities.AttributeGreaterOrEqual: ">=",
+	entities.AttributeLessThan:       "<",
+	entities.AttributeLessOrEqual:    "<=",
+}
+
+func orderBy(query entities.ItemQuery) string {
+	column, ok := sortColumns[query.SortBy]
+	if !ok {
+		column = "name"
+	}
+	direction := ""
+	if query.Descending {
+		direction = " DESC"
+	}
+	if column == "name" {
+		return column + direction
+	}
+	return column + direction + ", name"
+}
+
+type itemRow struct {
+	item                       entities.Item
+	itemType, attributes, tags sql.NullString
+}
+
+func (r *itemRow) targets() []any {
+	return []any{&r.item.ID, &r.item.Name, &r.item.Price, &r.item.Description,
+		&r.item.CreatedAt, &r.item.CreatedBy, &r.item.UpdatedAt, &r.item.UpdatedBy, &r.item.Currency, &r.itemType, &r.attributes, &r.item.Status, &r.tags}
+}
+
+func (r *itemRow) toItem() *entities.Item {
+	item := r.item
+	item.Type = r.itemType.String
+	if r.attributes.String != "" {
+		item.Attributes = json.RawMessage(r.attributes.String)
+	}
+	if r.tags.String != "" {
+		item.Tags = strings.Split(r.tags.String, ",")
+		sort.Strings(item.Tags)
+	}
+	return &item
+}
+
+func scanItem(row rowScanner) (*entities.Item, error) {
+	var r itemRow
+	if err := row.Scan(r.targets()...); err != nil {
+		return nil, err
+	}
+	return r.toItem(), nil
+}
+
+func nullableJSON(raw json.RawMessage) any {
+	if len(raw) == 0 {
+		return nil
+	}
+	return string(raw)
+}
+
+func insertOutboxEvent(tx *sql.Tx, eventType events.Type, item *entities.Item) error {
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox payload: %v", err)
+	}
+
+	now := time.Now().UTC()
+	_, err = tx.Exec("INSERT INTO outbox_events (event_type, aggregate_id, payload, created_at, next_attempt_at) VALUES (?, ?, ?, ?, ?)",
+		string(eventType), item.ID.String(), payload, now, now)
+	if err != nil {
+		return fmt.Errorf("failed to insert outbox event: %v", err)
+	}
+	return nil
+}
+
+func isDuplicateName(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed: items.name")
+}
+
+type notFoundError struct {
+	cause error
+}
+
+func (e notFoundError) Error() string {
+	return e.cause.Error()
+}
+
+func (e notFoundError) Unwrap() []error {
+	return []error{e.cause, entities.ErrItemNotFound}
 }
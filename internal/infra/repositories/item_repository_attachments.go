@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+const attachmentColumns = "id, filename, content_type, size, checksum, created_at, created_by"
+
+func (repo *ItemRepository_Impl) ListAttachments(itemID uuid.UUID) ([]*entities.Attachment, error) {
+	rows, err := repo.DB.Conn.Query("SELECT "+attachmentColumns+" FROM item_attachments WHERE item_id = ? ORDER BY created_at, id", itemID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attachments: %v", err)
+	}
+	defer rows.Close()
+
+	attachments := []*entities.Attachment{}
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+func (repo *ItemRepository_Impl) GetAttachment(itemID uuid.UUID, id uuid.UUID) (*entities.Attachment, error) {
+	attachment, err := scanAttachment(repo.DB.Conn.QueryRow("SELECT "+attachmentColumns+" FROM item_attachments WHERE item_id = ? AND id = ?", itemID.String(), id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("attachment '%s': %w", id, entities.ErrAttachmentNotFound)
+	}
+	return attachment, err
+}
+
+func (repo *ItemRepository_Impl) AddAttachment(itemID uuid.UUID, attachment *entities.Attachment) error {
+	res, err := repo.DB.Conn.Exec(`INSERT INTO item_attachments (item_id, `+attachmentColumns+`)
+		SELECT id, ?, ?, ?, ?, ?, ?, ? FROM items WHERE id = ?`,
+		attachment.ID.String(), attachment.Filename, attachment.ContentType, attachment.Size, attachment.Checksum, attachment.CreatedAt.UTC(), attachment.CreatedBy, itemID.String())
+	if err != nil {
+		return fmt.Errorf("failed to save attachment: %v", err)
+	}
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to save attachment: %v", err)
+	}
+	if inserted == 0 {
+		return fmt.Errorf("item '%s': %w", itemID, entities.ErrItemNotFound)
+	}
+	return nil
+}
+
+func (repo *ItemRepository_Impl) DeleteAttachment(itemID uuid.UUID, id uuid.UUID) error {
+	res, err := repo.DB.Conn.Exec("DELETE FROM item_attachments WHERE item_id = ? AND id = ?", itemID.String(), id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %v", err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %v", err)
+	}
+	if removed == 0 {
+		return fmt.Errorf("attachment '%s': %w", id, entities.ErrAttachmentNotFound)
+	}
+	return nil
+}
+
+func scanAttachment(row rowScanner) (*entities.Attachment, error) {
+	var attachment entities.Attachment
+	err := row.Scan(&attachment.ID, &attachment.Filename, &attachment.ContentType, &attachment.Size, &attachment.Checksum, &attachment.CreatedAt, &attachment.CreatedBy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan attachment row: %v", err)
+	}
+	return &attachment, nil
+}
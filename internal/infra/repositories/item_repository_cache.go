@@ -0,0 +1,318 @@
+package repositories
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/cache"
+)
+
+type CacheStats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Coalesced uint64 `json:"coalesced"`
+	Errors    uint64 `json:"errors"`
+}
+
+type CachingItemRepository struct {
+	Next  ItemRepository
+	Cache cache.Cache
+	TTL   time.Duration
+
+	group      singleflight.Group
+	mu         sync.Mutex
+	generation uint64
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+	coalesced  atomic.Uint64
+	errors     atomic.Uint64
+}
+
+func NewCachingItemRepository(next ItemRepository, store cache.Cache, ttl time.Duration) *CachingItemRepository {
+	return &CachingItemRepository{Next: next, Cache: store, TTL: ttl}
+}
+
+func (repo *CachingItemRepository) Stats() CacheStats {
+	return CacheStats{
+		Hits:      repo.hits.Load(),
+		Misses:    repo.misses.Load(),
+		Coalesced: repo.coalesced.Load(),
+		Errors:    repo.errors.Load(),
+	}
+}
+
+func (repo *CachingItemRepository) GetItems() ([]*entities.Item, error) {
+	return repo.Next.GetItems()
+}
+
+func (repo *CachingItemRepository) ListItems(query entities.ItemQuery) ([]*entities.Item, error) {
+	return repo.Next.ListItems(query)
+}
+
+func (repo *CachingItemRepository) StreamItems(statuses []string, fn func(item *entities.Item) error) error {
+	return repo.Next.StreamItems(statuses, fn)
+}
+
+func (repo *CachingItemRepository) GetItemByName(name string) (*entities.Item, error) {
+	return repo.load(nameKey(name), func() (*entities.Item, error) {
+		return repo.Next.GetItemByName(name)
+	})
+}
+
+func (repo *CachingItemRepository) GetItemByID(id uuid.UUID) (*entities.Item, error) {
+	return repo.load(idKey(id), func() (*entities.Item, error) {
+		return repo.Next.GetItemByID(id)
+	})
+}
+
+func (repo *CachingItemRepository) GetCollectionVersion() (*entities.CollectionVersion, error) {
+	return repo.Next.GetCollectionVersion()
+}
+
+func (repo *CachingItemRepository) CreateItem(item *entities.Item) (*entities.Item, error) {
+	created, err := repo.Next.CreateItem(item)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(created.Name), idKey(created.ID))
+	return created, nil
+}
+
+func (repo *CachingItemRepository) ImportItems(items []*entities.Item) error {
+	err := repo.Next.ImportItems(items)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, 2*len(items))
+	for _, item := range items {
+		keys = append(keys, nameKey(item.Name), idKey(item.ID))
+	}
+	repo.invalidate(keys...)
+	return nil
+}
+
+func (repo *CachingItemRepository) UpdateItem(name string, item *entities.Item) (*entities.Item, error) {
+	updated, err := repo.Next.UpdateItem(name, item)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(name), nameKey(updated.Name), idKey(updated.ID))
+	return updated, nil
+}
+
+func (repo *CachingItemRepository) DeleteItem(name string) error {
+	existing, lookupErr := repo.Next.GetItemByName(name)
+	err := repo.Next.DeleteItem(name)
+	if err != nil {
+		return err
+	}
+	keys := []string{nameKey(name)}
+	if lookupErr == nil {
+		keys = append(keys, idKey(existing.ID))
+	}
+	repo.invalidate(keys...)
+	return nil
+}
+
+func (repo *CachingItemRepository) SetItemStatus(name string, from string, to string, actor string, at time.Time) (*entities.Item, error) {
+	changed, err := repo.Next.SetItemStatus(name, from, to, actor, at)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(changed.Name), idKey(changed.ID))
+	return changed, nil
+}
+
+func (repo *CachingItemRepository) AddTag(name string, tag string, actor string, at time.Time) (*entities.Item, bool, error) {
+	tagged, added, err := repo.Next.AddTag(name, tag, actor, at)
+	if err != nil {
+		return nil, false, err
+	}
+	if added {
+		repo.invalidate(nameKey(tagged.Name), idKey(tagged.ID))
+	}
+	return tagged, added, nil
+}
+
+func (repo *CachingItemRepository) RemoveTag(name string, tag string, actor string, at time.Time) (*entities.Item, error) {
+	untagged, err := repo.Next.RemoveTag(name, tag, actor, at)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(untagged.Name), idKey(untagged.ID))
+	return untagged, nil
+}
+
+func (repo *CachingItemRepository) ListTags() ([]*entities.TagCount, error) {
+	return repo.Next.ListTags()
+}
+
+func (repo *CachingItemRepository) ListPriceChanges(itemID uuid.UUID) ([]*entities.PriceChange, error) {
+	return repo.Next.ListPriceChanges(itemID)
+}
+
+func (repo *CachingItemRepository) GetPriceChange(itemID uuid.UUID, id uuid.UUID) (*entities.PriceChange, error) {
+	return repo.Next.GetPriceChange(itemID, id)
+}
+
+func (repo *CachingItemRepository) GetPriceAt(itemID uuid.UUID, at time.Time) (*entities.PriceChange, error) {
+	return repo.Next.GetPriceAt(itemID, at)
+}
+
+func (repo *CachingItemRepository) SchedulePriceChange(change *entities.PriceChange) error {
+	return repo.Next.SchedulePriceChange(change)
+}
+
+func (repo *CachingItemRepository) CancelPriceChange(itemID uuid.UUID, id uuid.UUID) error {
+	return repo.Next.CancelPriceChange(itemID, id)
+}
+
+func (repo *CachingItemRepository) DuePriceChanges(now time.Time, limit int) ([]*entities.PriceChange, error) {
+	return repo.Next.DuePriceChanges(now, limit)
+}
+
+func (repo *CachingItemRepository) ApplyPriceChange(id uuid.UUID, at time.Time) (*entities.Item, error) {
+	updated, err := repo.Next.ApplyPriceChange(id, at)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(updated.Name), idKey(updated.ID))
+	return updated, nil
+}
+
+func (repo *CachingItemRepository) ListTranslations(itemID uuid.UUID) ([]*entities.Translation, error) {
+	return repo.Next.ListTranslations(itemID)
+}
+
+func (repo *CachingItemRepository) GetTranslation(itemID uuid.UUID, locale string) (*entities.Translation, error) {
+	return repo.Next.GetTranslation(itemID, locale)
+}
+
+func (repo *CachingItemRepository) TranslationsIn(itemIDs []uuid.UUID, locales []string) (map[uuid.UUID]map[string]*entities.Translation, error) {
+	return repo.Next.TranslationsIn(itemIDs, locales)
+}
+
+func (repo *CachingItemRepository) SetTranslation(name string, t *entities.Translation) (*entities.Item, error) {
+	translated, err := repo.Next.SetTranslation(name, t)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(translated.Name), idKey(translated.ID))
+	return translated, nil
+}
+
+func (repo *CachingItemRepository) DeleteTranslation(name string, locale string, actor string, at time.Time) (*entities.Item, error) {
+	untranslated, err := repo.Next.DeleteTranslation(name, locale, actor, at)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(untranslated.Name), idKey(untranslated.ID))
+	return untranslated, nil
+}
+
+func (repo *CachingItemRepository) GetItemWithVariants(name string) (*entities.Item, error) {
+	return repo.Next.GetItemWithVariants(name)
+}
+
+func (repo *CachingItemRepository) SaveVariant(name string, v *entities.Variant) (*entities.Item, error) {
+	saved, err := repo.Next.SaveVariant(name, v)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(saved.Name), idKey(saved.ID))
+	return saved, nil
+}
+
+func (repo *CachingItemRepository) DeleteVariant(name string, sku string, actor string, at time.Time) (*entities.Item, error) {
+	removed, err := repo.Next.DeleteVariant(name, sku, actor, at)
+	if err != nil {
+		return nil, err
+	}
+	repo.invalidate(nameKey(removed.Name), idKey(removed.ID))
+	return removed, nil
+}
+
+func (repo *CachingItemRepository) load(key string, fetch func() (*entities.Item, error)) (*entities.Item, error) {
+	cached, found, err := repo.Cache.Get(key)
+	if err != nil {
+		repo.errors.Add(1)
+		log.Printf("Failed to read %s from cache: %v", key, err)
+	}
+	if found {
+		var item entities.Item
+		if err := json.Unmarshal(cached, &item); err == nil {
+			repo.hits.Add(1)
+			return &item, nil
+		}
+		repo.errors.Add(1)
+	}
+	repo.misses.Add(1)
+
+	value, err, shared := repo.group.Do(key, func() (any, error) {
+		generation := repo.currentGeneration()
+		item, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := json.Marshal(item)
+		if err == nil {
+			err = repo.store(key, encoded, generation)
+		}
+		if err != nil {
+			repo.errors.Add(1)
+			log.Printf("Failed to write %s to cache: %v", key, err)
+		}
+		return item, nil
+	})
+	if shared {
+		repo.coalesced.Add(1)
+	}
+	if err != nil {
+		return nil, err
+	}
+	copied := *value.(*entities.Item)
+	return &copied, nil
+}
+
+func (repo *CachingItemRepository) currentGeneration() uint64 {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.generation
+}
+
+func (repo *CachingItemRepository) store(key string, encoded []byte, generation uint64) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.generation != generation {
+		return nil
+	}
+	return repo.Cache.Set(key, encoded, repo.TTL)
+}
+
+func (repo *CachingItemRepository) invalidate(keys ...string) {
+	repo.mu.Lock()
+	repo.generation++
+	repo.mu.Unlock()
+	for _, key := range keys {
+		repo.group.Forget(key)
+	}
+	if err := repo.Cache.Delete(keys...); err != nil {
+		repo.errors.Add(1)
+		log.Printf("Failed to invalidate cached items: %v", err)
+	}
+}
+
+func nameKey(name string) string {
+	return "item:name:" + name
+}
+
+func idKey(id uuid.UUID) string {
+	return "item:id:" + id.String()
+}
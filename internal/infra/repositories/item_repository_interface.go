@@ -1,13 +1,41 @@
 package repositories
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
 	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
 )
 
 type ItemRepository interface {
 	GetItems() ([]*entities.Item, error)
+	ListItems(query entities.ItemQuery) ([]*entities.Item, error)
+	StreamItems(statuses []string, fn func(item *entities.Item) error) error
 	GetItemByName(name string) (*entities.Item, error)
-	CreateItem(item *entities.Item) error
-	UpdateItem(name string, item *entities.Item) error
+	GetItemByID(id uuid.UUID) (*entities.Item, error)
+	GetCollectionVersion() (*entities.CollectionVersion, error)
+	CreateItem(item *entities.Item) (*entities.Item, error)
+	ImportItems(items []*entities.Item) error
+	UpdateItem(name string, item *entities.Item) (*entities.Item, error)
 	DeleteItem(name string) error
+	SetItemStatus(name string, from string, to string, actor string, at time.Time) (*entities.Item, error)
+	AddTag(name string, tag string, actor string, at time.Time) (*entities.Item, bool, error)
+	RemoveTag(name string, tag string, actor string, at time.Time) (*entities.Item, error)
+	ListTags() ([]*entities.TagCount, error)
+	ListPriceChanges(itemID uuid.UUID) ([]*entities.PriceChange, error)
+	GetPriceChange(itemID uuid.UUID, id uuid.UUID) (*entities.PriceChange, error)
+	GetPriceAt(itemID uuid.UUID, at time.Time) (*entities.PriceChange, error)
+	SchedulePriceChange(change *entities.PriceChange) error
+	CancelPriceChange(itemID uuid.UUID, id uuid.UUID) error
+	DuePriceChanges(now time.Time, limit int) ([]*entities.PriceChange, error)
+	ApplyPriceChange(id uuid.UUID, at time.Time) (*entities.Item, error)
+	ListTranslations(itemID uuid.UUID) ([]*entities.Translation, error)
+	GetTranslation(itemID uuid.UUID, locale string) (*entities.Translation, error)
+	TranslationsIn(itemIDs []uuid.UUID, locales []string) (map[uuid.UUID]map[string]*entities.Translation, error)
+	SetTranslation(name string, t *entities.Translation) (*entities.Item, error)
+	DeleteTranslation(name string, locale string, actor string, at time.Time) (*entities.Item, error)
+	GetItemWithVariants(name string) (*entities.Item, error)
+	SaveVariant(name string, v *entities.Variant) (*entities.Item, error)
+	DeleteVariant(name string, sku string, actor string, at time.Time) (*entities.Item, error)
 }
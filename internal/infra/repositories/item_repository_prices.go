@@ -0,0 +1,184 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+)
+
+const priceColumns = "id, item_id, price, effective_from, applied_at, created_at, created_by"
+
+func (repo *ItemRepository_Impl) ListPriceChanges(itemID uuid.UUID) ([]*entities.PriceChange, error) {
+	changes := []*entities.PriceChange{}
+
+	rows, err := repo.DB.Conn.Query("SELECT "+priceColumns+" FROM item_prices WHERE item_id = ? ORDER BY effective_from DESC, rowid DESC", itemID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch price history: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change, err := scanPriceChange(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan price change row: %v", err)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+func (repo *ItemRepository_Impl) GetPriceChange(itemID uuid.UUID, id uuid.UUID) (*entities.PriceChange, error) {
+	change, err := scanPriceChange(repo.DB.Conn.QueryRow("SELECT "+priceColumns+" FROM item_prices WHERE id = ? AND item_id = ?", id.String(), itemID.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("price change '%s' not found: %w", id, entities.ErrPriceChangeNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price change: %v", err)
+	}
+	return change, nil
+}
+
+func (repo *ItemRepository_Impl) GetPriceAt(itemID uuid.UUID, at time.Time) (*entities.PriceChange, error) {
+	change, err := scanPriceChange(repo.DB.Conn.QueryRow("SELECT "+priceColumns+" FROM item_prices WHERE item_id = ? AND effective_from <= ? ORDER BY effective_from DESC, rowid DESC LIMIT 1",
+		itemID.String(), at.UTC()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("no price recorded for item '%s' at %s: %w", itemID, at.UTC().Format(time.RFC3339), entities.ErrPriceChangeNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price: %v", err)
+	}
+	return change, nil
+}
+
+func (repo *ItemRepository_Impl) SchedulePriceChange(change *entities.PriceChange) error {
+	var count int
+	err := repo.DB.Conn.QueryRow("SELECT COUNT(*) FROM items WHERE id = ?", change.ItemID.String()).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("failed to check item: %v", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("item '%s' not found: %w", change.ItemID, entities.ErrItemNotFound)
+	}
+
+	return insertPriceChange(repo.DB.Conn, change)
+}
+
+func (repo *ItemRepository_Impl) CancelPriceChange(itemID uuid.UUID, id uuid.UUID) error {
+	res, err := repo.DB.Conn.Exec("DELETE FROM item_prices WHERE id = ? AND item_id = ? AND applied_at IS NULL", id.String(), itemID.String())
+	if err != nil {
+		return fmt.Errorf("failed to cancel price change: %v", err)
+	}
+	cancelled, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to cancel price change: %v", err)
+	}
+	if cancelled == 0 {
+		return fmt.Errorf("no pending price change '%s': %w", id, entities.ErrPriceChangeNotFound)
+	}
+	return nil
+}
+
+func (repo *ItemRepository_Impl) DuePriceChanges(now time.Time, limit int) ([]*entities.PriceChange, error) {
+	changes := []*entities.PriceChange{}
+
+	rows, err := repo.DB.Conn.Query("SELECT "+priceColumns+" FROM item_prices WHERE applied_at IS NULL AND effective_from <= ? ORDER BY effective_from, rowid LIMIT ?", now.UTC(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch due price changes: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change, err := scanPriceChange(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan price change row: %v", err)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+func (repo *ItemRepository_Impl) ApplyPriceChange(id uuid.UUID, at time.Time) (*entities.Item, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	change, err := scanPriceChange(tx.QueryRow("SELECT "+priceColumns+" FROM item_prices WHERE id = ? AND applied_at IS NULL", id.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		err = fmt.Errorf("no pending price change '%s': %w", id, entities.ErrPriceChangeNotFound)
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price change: %v", err)
+	}
+
+	_, err = tx.Exec("UPDATE item_prices SET applied_at = ? WHERE id = ?", at.UTC(), id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark price change applied: %v", err)
+	}
+
+	_, err = tx.Exec("UPDATE items SET price = ?, updated_at = ?, updated_by = ? WHERE id = ?",
+		change.Price, at.UTC(), change.CreatedBy, change.ItemID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply price change: %v", err)
+	}
+
+	item, err := scanItem(tx.QueryRow("SELECT "+itemSelect+" FROM items WHERE id = ?", change.ItemID.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item '%s' in transaction: %v", change.ItemID, err)
+	}
+
+	err = insertOutboxEvent(tx, events.ItemUpdated, item)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit price change: %v", err)
+	}
+	return item, nil
+}
+
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+func insertPriceChange(db execer, change *entities.PriceChange) error {
+	var appliedAt any
+	if change.AppliedAt != nil {
+		appliedAt = change.AppliedAt.UTC()
+	}
+	_, err := db.Exec("INSERT INTO item_prices ("+priceColumns+") VALUES (?, ?, ?, ?, ?, ?, ?)",
+		change.ID.String(), change.ItemID.String(), change.Price, change.EffectiveFrom.UTC(), appliedAt, change.CreatedAt.UTC(), change.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to record price change: %v", err)
+	}
+	return nil
+}
+
+func scanPriceChange(row rowScanner) (*entities.PriceChange, error) {
+	var change entities.PriceChange
+	var appliedAt sql.NullTime
+	err := row.Scan(&change.ID, &change.ItemID, &change.Price, &change.EffectiveFrom, &appliedAt, &change.CreatedAt, &change.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	if appliedAt.Valid {
+		change.AppliedAt = &appliedAt.Time
+	}
+	return &change, nil
+}
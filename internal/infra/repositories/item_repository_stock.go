@@ -0,0 +1,295 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+const activeReservations = "(SELECT COALESCE(SUM(quantity), 0) FROM stock_reservations WHERE item_id = ? AND expires_at > ?)"
+
+const reservationColumns = "id, item_id, quantity, expires_at, created_at, created_by"
+
+type rowQueryer interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+func (repo *ItemRepository_Impl) GetStock(itemID uuid.UUID, now time.Time) (*entities.Stock, error) {
+	return getStock(repo.DB.Conn, itemID, now)
+}
+
+func (repo *ItemRepository_Impl) AdjustStock(itemID uuid.UUID, kind entities.MovementKind, delta int, reason string, actor string, at time.Time) (*entities.Stock, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	stock, err := adjustStockInTx(tx, itemID, kind, delta, reason, actor, nil, at)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit stock adjustment: %v", err)
+	}
+	return stock, nil
+}
+
+func (repo *ItemRepository_Impl) CorrectStock(itemID uuid.UUID, onHand int, reason string, actor string, at time.Time) (*entities.Stock, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	err = ensureStockRow(tx, itemID, at)
+	if err != nil {
+		return nil, err
+	}
+	current, err := getStock(tx, itemID, at)
+	if err != nil {
+		return nil, err
+	}
+	if onHand < current.Reserved {
+		err = fmt.Errorf("cannot set stock of item '%s' to %d while %d units are reserved: %w", itemID, onHand, current.Reserved, entities.ErrInsufficientStock)
+		return nil, err
+	}
+
+	_, err = tx.Exec("UPDATE item_stock SET on_hand = ?, updated_at = ? WHERE item_id = ?", onHand, at.UTC(), itemID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to correct stock: %v", err)
+	}
+
+	stock := &entities.Stock{ItemID: itemID, OnHand: onHand, Reserved: current.Reserved, Available: onHand - current.Reserved}
+	err = insertMovement(tx, stock, entities.MovementCorrect, onHand-current.OnHand, reason, actor, nil, at)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit stock correction: %v", err)
+	}
+	return stock, nil
+}
+
+func (repo *ItemRepository_Impl) ListMovements(itemID uuid.UUID, limit int) ([]*entities.StockMovement, error) {
+	movements := []*entities.StockMovement{}
+
+	rows, err := repo.DB.Conn.Query(`SELECT id, item_id, kind, quantity, on_hand_after, reason, reservation_id, created_at, created_by
+		FROM stock_movements WHERE item_id = ? ORDER BY rowid DESC LIMIT ?`, itemID.String(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stock movements: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var movement entities.StockMovement
+		var reservationID sql.NullString
+		err := rows.Scan(&movement.ID, &movement.ItemID, &movement.Kind, &movement.Quantity, &movement.OnHandAfter,
+			&movement.Reason, &reservationID, &movement.CreatedAt, &movement.CreatedBy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan stock movement row: %v", err)
+		}
+		if reservationID.Valid {
+			id, err := uuid.Parse(reservationID.String)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse reservation id: %v", err)
+			}
+			movement.ReservationID = &id
+		}
+		movements = append(movements, &movement)
+	}
+
+	return movements, rows.Err()
+}
+
+func (repo *ItemRepository_Impl) Reserve(reservation *entities.Reservation) error {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	itemID, now := reservation.ItemID.String(), reservation.CreatedAt.UTC()
+	_, err = tx.Exec("DELETE FROM stock_reservations WHERE item_id = ? AND expires_at <= ?", itemID, now)
+	if err != nil {
+		return fmt.Errorf("failed to purge expired reservations: %v", err)
+	}
+
+	res, err := tx.Exec("INSERT INTO stock_reservations ("+reservationColumns+") SELECT ?, ?, ?, ?, ?, ?"+
+		" WHERE COALESCE((SELECT on_hand FROM item_stock WHERE item_id = ?), 0) - "+activeReservations+" >= ?",
+		reservation.ID.String(), itemID, reservation.Quantity, reservation.ExpiresAt.UTC(), now, reservation.CreatedBy,
+		itemID, itemID, now, reservation.Quantity)
+	if err != nil {
+		return fmt.Errorf("failed to reserve stock: %v", err)
+	}
+	reserved, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to reserve stock: %v", err)
+	}
+	if reserved == 0 {
+		err = insufficientStock(tx, reservation.ItemID, "reserve", reservation.Quantity, now)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit reservation: %v", err)
+	}
+	return nil
+}
+
+func (repo *ItemRepository_Impl) GetReservation(id uuid.UUID, now time.Time) (*entities.Reservation, error) {
+	return getReservation(repo.DB.Conn, id, now)
+}
+
+func (repo *ItemRepository_Impl) ReleaseReservation(id uuid.UUID, now time.Time) error {
+	res, err := repo.DB.Conn.Exec("DELETE FROM stock_reservations WHERE id = ? AND expires_at > ?", id.String(), now.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to release reservation: %v", err)
+	}
+	released, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to release reservation: %v", err)
+	}
+	if released == 0 {
+		return fmt.Errorf("reservation '%s' not found or expired: %w", id, entities.ErrReservationNotFound)
+	}
+	return nil
+}
+
+func (repo *ItemRepository_Impl) CommitReservation(id uuid.UUID, actor string, at time.Time) (*entities.Stock, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	reservation, err := getReservation(tx, id, at)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec("DELETE FROM stock_reservations WHERE id = ?", id.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit reservation: %v", err)
+	}
+
+	stock, err := adjustStockInTx(tx, reservation.ItemID, entities.MovementShip, -reservation.Quantity, "", actor, &reservation.ID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit reservation: %v", err)
+	}
+	return stock, nil
+}
+
+func adjustStockInTx(tx *sql.Tx, itemID uuid.UUID, kind entities.MovementKind, delta int, reason string, actor string, reservationID *uuid.UUID, at time.Time) (*entities.Stock, error) {
+	err := ensureStockRow(tx, itemID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := tx.Exec("UPDATE item_stock SET on_hand = on_hand + ?, updated_at = ? WHERE item_id = ? AND on_hand + ? >= "+activeReservations,
+		delta, at.UTC(), itemID.String(), delta, itemID.String(), at.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to adjust stock: %v", err)
+	}
+	adjusted, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to adjust stock: %v", err)
+	}
+	if adjusted == 0 {
+		return nil, insufficientStock(tx, itemID, string(kind), -delta, at)
+	}
+
+	stock, err := getStock(tx, itemID, at)
+	if err != nil {
+		return nil, err
+	}
+	err = insertMovement(tx, stock, kind, delta, reason, actor, reservationID, at)
+	if err != nil {
+		return nil, err
+	}
+	return stock, nil
+}
+
+func ensureStockRow(tx *sql.Tx, itemID uuid.UUID, at time.Time) error {
+	_, err := tx.Exec("INSERT INTO item_stock (item_id, on_hand, updated_at) VALUES (?, 0, ?) ON CONFLICT(item_id) DO NOTHING", itemID.String(), at.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to initialise stock: %v", err)
+	}
+	return nil
+}
+
+func getStock(q rowQueryer, itemID uuid.UUID, now time.Time) (*entities.Stock, error) {
+	stock := entities.Stock{ItemID: itemID}
+
+	err := q.QueryRow("SELECT COALESCE((SELECT on_hand FROM item_stock WHERE item_id = ?), 0), "+activeReservations,
+		itemID.String(), itemID.String(), now.UTC()).Scan(&stock.OnHand, &stock.Reserved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock: %v", err)
+	}
+
+	stock.Available = stock.OnHand - stock.Reserved
+	return &stock, nil
+}
+
+func getReservation(q rowQueryer, id uuid.UUID, now time.Time) (*entities.Reservation, error) {
+	var reservation entities.Reservation
+
+	err := q.QueryRow("SELECT "+reservationColumns+" FROM stock_reservations WHERE id = ? AND expires_at > ?", id.String(), now.UTC()).
+		Scan(&reservation.ID, &reservation.ItemID, &reservation.Quantity, &reservation.ExpiresAt, &reservation.CreatedAt, &reservation.CreatedBy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("reservation '%s' not found or expired: %w", id, entities.ErrReservationNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation: %v", err)
+	}
+	return &reservation, nil
+}
+
+func insertMovement(tx *sql.Tx, stock *entities.Stock, kind entities.MovementKind, delta int, reason string, actor string, reservationID *uuid.UUID, at time.Time) error {
+	_, err := tx.Exec(`INSERT INTO stock_movements (id, item_id, kind, quantity, on_hand_after, reason, reservation_id, created_at, created_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		uuid.New().String(), stock.ItemID.String(), string(kind), delta, stock.OnHand, reason, nullableID(reservationID), at.UTC(), actor)
+	if err != nil {
+		return fmt.Errorf("failed to record stock movement: %v", err)
+	}
+	return nil
+}
+
+func insufficientStock(tx *sql.Tx, itemID uuid.UUID, action string, quantity int, now time.Time) error {
+	stock, err := getStock(tx, itemID, now)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("cannot %s %d units of item '%s', only %d available: %w", action, quantity, itemID, stock.Available, entities.ErrInsufficientStock)
+}
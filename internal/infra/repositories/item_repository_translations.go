@@ -0,0 +1,166 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+const translationColumns = "locale, name, description, updated_at, updated_by"
+
+func (repo *ItemRepository_Impl) ListTranslations(itemID uuid.UUID) ([]*entities.Translation, error) {
+	translations := []*entities.Translation{}
+
+	rows, err := repo.DB.Conn.Query("SELECT "+translationColumns+" FROM item_translations WHERE item_id = ? ORDER BY locale", itemID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch translations: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		t, err := scanTranslation(rows)
+		if err != nil {
+			return nil, err
+		}
+		translations = append(translations, t)
+	}
+
+	return translations, rows.Err()
+}
+
+func (repo *ItemRepository_Impl) GetTranslation(itemID uuid.UUID, locale string) (*entities.Translation, error) {
+	t, err := scanTranslation(repo.DB.Conn.QueryRow("SELECT "+translationColumns+" FROM item_translations WHERE item_id = ? AND locale = ?", itemID.String(), locale))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("item '%s' has no %s translation: %w", itemID, locale, entities.ErrTranslationNotFound)
+	}
+	return t, err
+}
+
+func (repo *ItemRepository_Impl) TranslationsIn(itemIDs []uuid.UUID, locales []string) (map[uuid.UUID]map[string]*entities.Translation, error) {
+	translations := map[uuid.UUID]map[string]*entities.Translation{}
+	if len(itemIDs) == 0 || len(locales) == 0 {
+		return translations, nil
+	}
+
+	args := make([]any, 0, len(itemIDs)+len(locales))
+	for _, itemID := range itemIDs {
+		args = append(args, itemID.String())
+	}
+	for _, locale := range locales {
+		args = append(args, locale)
+	}
+	rows, err := repo.DB.Conn.Query("SELECT item_id, "+translationColumns+" FROM item_translations WHERE item_id IN (?"+strings.Repeat(", ?", len(itemIDs)-1)+
+		") AND locale IN (?"+strings.Repeat(", ?", len(locales)-1)+")", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch translations: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var itemID uuid.UUID
+		var t entities.Translation
+		if err := rows.Scan(&itemID, &t.Locale, &t.Name, &t.Description, &t.UpdatedAt, &t.UpdatedBy); err != nil {
+			return nil, fmt.Errorf("failed to scan translation row: %v", err)
+		}
+		if translations[itemID] == nil {
+			translations[itemID] = map[string]*entities.Translation{}
+		}
+		translations[itemID][t.Locale] = &t
+	}
+
+	return translations, rows.Err()
+}
+
+func (repo *ItemRepository_Impl) SetTranslation(name string, t *entities.Translation) (*entities.Item, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate item '%s': %w", name, err)
+	}
+
+	_, err = tx.Exec(`INSERT INTO item_translations (item_id, `+translationColumns+`) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(item_id, locale) DO UPDATE SET name = excluded.name, description = excluded.description, updated_at = excluded.updated_at, updated_by = excluded.updated_by`,
+		existing.ID.String(), t.Locale, t.Name, t.Description, t.UpdatedAt.UTC(), t.UpdatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save translation: %v", err)
+	}
+
+	err = repo.touchItemInTx(tx, existing, t.UpdatedBy, t.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit translation: %v", err)
+	}
+	return existing, nil
+}
+
+func (repo *ItemRepository_Impl) DeleteTranslation(name string, locale string, actor string, at time.Time) (*entities.Item, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete translation of item '%s': %w", name, err)
+	}
+
+	res, err := tx.Exec("DELETE FROM item_translations WHERE item_id = ? AND locale = ?", existing.ID.String(), locale)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete translation: %v", err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete translation: %v", err)
+	}
+	if removed == 0 {
+		err = fmt.Errorf("item '%s' has no %s translation: %w", name, locale, entities.ErrTranslationNotFound)
+		return nil, err
+	}
+
+	err = repo.touchItemInTx(tx, existing, actor, at)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit translation removal: %v", err)
+	}
+	return existing, nil
+}
+
+func scanTranslation(row rowScanner) (*entities.Translation, error) {
+	var t entities.Translation
+	err := row.Scan(&t.Locale, &t.Name, &t.Description, &t.UpdatedAt, &t.UpdatedBy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan translation row: %v", err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,170 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+const variantColumns = "id, sku, options, price, created_at, created_by, updated_at, updated_by"
+
+var itemWithVariantsSelect = "items." + strings.ReplaceAll(itemColumns, ", ", ", items.") + ", " + itemTagsSelect +
+	", item_variants." + strings.ReplaceAll(variantColumns, ", ", ", item_variants.")
+
+func (repo *ItemRepository_Impl) GetItemWithVariants(name string) (*entities.Item, error) {
+	rows, err := repo.DB.Conn.Query("SELECT "+itemWithVariantsSelect+" FROM items LEFT JOIN item_variants ON item_variants.item_id = items.id WHERE items.name = ? ORDER BY item_variants.sku", name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch item '%s' with variants: %v", name, err)
+	}
+	defer rows.Close()
+
+	var item *entities.Item
+	for rows.Next() {
+		var r itemRow
+		var id, sku, options, createdBy, updatedBy sql.NullString
+		var price sql.NullFloat64
+		var createdAt, updatedAt sql.NullTime
+		err := rows.Scan(append(r.targets(), &id, &sku, &options, &price, &createdAt, &createdBy, &updatedAt, &updatedBy)...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item variant row: %v", err)
+		}
+		if item == nil {
+			item = r.toItem()
+			item.Variants = []*entities.Variant{}
+		}
+		if !id.Valid {
+			continue
+		}
+
+		v := entities.Variant{SKU: sku.String, CreatedAt: createdAt.Time, CreatedBy: createdBy.String, UpdatedAt: updatedAt.Time, UpdatedBy: updatedBy.String}
+		if err := v.ID.Scan(id.String); err != nil {
+			return nil, fmt.Errorf("failed to scan item variant row: %v", err)
+		}
+		if err := decodeVariantOptions(&v, options.String); err != nil {
+			return nil, err
+		}
+		if price.Valid {
+			v.Price = &price.Float64
+		}
+		item.Variants = append(item.Variants, &v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to fetch item '%s' with variants: %v", name, err)
+	}
+	if item == nil {
+		return nil, fmt.Errorf("item '%s' not found: %w", name, notFoundError{sql.ErrNoRows})
+	}
+
+	item.PriceVariants()
+	return item, nil
+}
+
+func (repo *ItemRepository_Impl) SaveVariant(name string, v *entities.Variant) (*entities.Item, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save variant of item '%s': %w", name, err)
+	}
+
+	var owner string
+	err = tx.QueryRow("SELECT item_id FROM item_variants WHERE sku = ?", v.SKU).Scan(&owner)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to check SKU %s: %v", v.SKU, err)
+	case owner != existing.ID.String():
+		err = fmt.Errorf("SKU %s belongs to another item: %w", v.SKU, entities.ErrVariantAlreadyExists)
+		return nil, err
+	}
+
+	var price any
+	if v.Price != nil {
+		price = *v.Price
+	}
+	_, err = tx.Exec(`INSERT INTO item_variants (item_id, `+variantColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(sku) DO UPDATE SET options = excluded.options, price = excluded.price, updated_at = excluded.updated_at, updated_by = excluded.updated_by`,
+		existing.ID.String(), v.ID.String(), v.SKU, v.OptionKey(), price, v.CreatedAt.UTC(), v.CreatedBy, v.UpdatedAt.UTC(), v.UpdatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save variant: %v", err)
+	}
+
+	err = repo.touchItemInTx(tx, existing, v.UpdatedBy, v.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit variant: %v", err)
+	}
+	return existing, nil
+}
+
+func (repo *ItemRepository_Impl) DeleteVariant(name string, sku string, actor string, at time.Time) (*entities.Item, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing, err := repo.getItemByNameInTx(tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete variant of item '%s': %w", name, err)
+	}
+
+	res, err := tx.Exec("DELETE FROM item_variants WHERE item_id = ? AND sku = ?", existing.ID.String(), sku)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete variant: %v", err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete variant: %v", err)
+	}
+	if removed == 0 {
+		err = fmt.Errorf("item '%s' has no variant %s: %w", name, sku, entities.ErrVariantNotFound)
+		return nil, err
+	}
+
+	err = repo.touchItemInTx(tx, existing, actor, at)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit variant removal: %v", err)
+	}
+	return existing, nil
+}
+
+func decodeVariantOptions(v *entities.Variant, encoded string) error {
+	var values map[string]string
+	if err := json.Unmarshal([]byte(encoded), &values); err != nil {
+		return fmt.Errorf("failed to decode options of variant %s: %v", v.SKU, err)
+	}
+	for name, value := range values {
+		v.Options = append(v.Options, entities.VariantOption{Name: name, Value: value})
+	}
+	sort.Slice(v.Options, func(i, j int) bool { return v.Options[i].Name < v.Options[j].Name })
+	return nil
+}
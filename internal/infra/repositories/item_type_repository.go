@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	database "github.com/afornagieri/go_api_template/internal/infra/database"
+)
+
+const itemTypeColumns = "name, description, schema, created_at, created_by, updated_at, updated_by"
+
+type ItemTypeRepository_Impl struct {
+	DB *database.SqlCli
+}
+
+func NewItemTypeRepository(db *database.SqlCli) *ItemTypeRepository_Impl {
+	return &ItemTypeRepository_Impl{DB: db}
+}
+
+func (repo *ItemTypeRepository_Impl) ListItemTypes() ([]*entities.ItemType, error) {
+	rows, err := repo.DB.Conn.Query("SELECT " + itemTypeColumns + " FROM item_types ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch item types: %v", err)
+	}
+	defer rows.Close()
+
+	itemTypes := []*entities.ItemType{}
+	for rows.Next() {
+		itemType, err := scanItemType(rows)
+		if err != nil {
+			return nil, err
+		}
+		itemTypes = append(itemTypes, itemType)
+	}
+
+	return itemTypes, rows.Err()
+}
+
+func (repo *ItemTypeRepository_Impl) GetItemType(name string) (*entities.ItemType, error) {
+	itemType, err := scanItemType(repo.DB.Conn.QueryRow("SELECT "+itemTypeColumns+" FROM item_types WHERE name = ?", name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("item type '%s' not found: %w", name, entities.ErrItemTypeNotFound)
+	}
+	return itemType, err
+}
+
+func (repo *ItemTypeRepository_Impl) SaveItemType(itemType *entities.ItemType) error {
+	_, err := repo.DB.Conn.Exec(`INSERT INTO item_types (`+itemTypeColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET description = excluded.description, schema = excluded.schema,
+		updated_at = excluded.updated_at, updated_by = excluded.updated_by`,
+		itemType.Name, itemType.Description, string(itemType.Schema),
+		itemType.CreatedAt.UTC(), itemType.CreatedBy, itemType.UpdatedAt.UTC(), itemType.UpdatedBy)
+	if err != nil {
+		return fmt.Errorf("failed to save item type: %v", err)
+	}
+	return nil
+}
+
+func (repo *ItemTypeRepository_Impl) DeleteItemType(name string) error {
+	res, err := repo.DB.Conn.Exec("DELETE FROM item_types WHERE name = ? AND NOT EXISTS (SELECT 1 FROM items WHERE type = ?)", name, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete item type: %v", err)
+	}
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete item type: %v", err)
+	}
+	if removed > 0 {
+		return nil
+	}
+
+	if _, err := repo.GetItemType(name); err != nil {
+		return err
+	}
+	return fmt.Errorf("item type '%s' is still assigned to items: %w", name, entities.ErrItemTypeInUse)
+}
+
+func scanItemType(row rowScanner) (*entities.ItemType, error) {
+	var itemType entities.ItemType
+	var schema string
+	err := row.Scan(&itemType.Name, &itemType.Description, &schema,
+		&itemType.CreatedAt, &itemType.CreatedBy, &itemType.UpdatedAt, &itemType.UpdatedBy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan item type row: %v", err)
+	}
+	itemType.Schema = json.RawMessage(schema)
+	return &itemType, nil
+}
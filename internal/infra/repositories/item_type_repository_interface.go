@@ -0,0 +1,12 @@
+package repositories
+
+import (
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type ItemTypeRepository interface {
+	ListItemTypes() ([]*entities.ItemType, error)
+	GetItemType(name string) (*entities.ItemType, error)
+	SaveItemType(itemType *entities.ItemType) error
+	DeleteItemType(name string) error
+}
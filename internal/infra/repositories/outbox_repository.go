@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"fmt"
+	"time"
+
+	database "github.com/afornagieri/go_api_template/internal/infra/database"
+)
+
+type OutboxRepository_Impl struct {
+	DB *database.SqlCli
+}
+
+func NewOutboxRepository(db *database.SqlCli) *OutboxRepository_Impl {
+	return &OutboxRepository_Impl{DB: db}
+}
+
+func (repo *OutboxRepository_Impl) PendingEvents(now time.Time, limit int) ([]*OutboxRecord, error) {
+	var records []*OutboxRecord
+
+	rows, err := repo.DB.Conn.Query(`SELECT o.id, o.event_type, o.aggregate_id, o.payload, o.attempts, o.created_at
+		FROM outbox_events o
+		WHERE o.delivered_at IS NULL AND o.next_attempt_at <= ?
+		AND NOT EXISTS (
+			SELECT 1 FROM outbox_events e
+			WHERE e.aggregate_id = o.aggregate_id AND e.delivered_at IS NULL AND e.id < o.id
+		)
+		ORDER BY o.id LIMIT ?`, now.UTC(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pending outbox events: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record OutboxRecord
+
+		err := rows.Scan(&record.ID, &record.EventType, &record.AggregateID, &record.Payload, &record.Attempts, &record.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan outbox row: %v", err)
+		}
+
+		records = append(records, &record)
+	}
+
+	return records, rows.Err()
+}
+
+func (repo *OutboxRepository_Impl) MarkDelivered(id int64, deliveredAt time.Time) error {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec("UPDATE outbox_events SET delivered_at = ?, attempts = attempts + 1, last_error = NULL WHERE id = ?", deliveredAt.UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event %d delivered: %v", id, err)
+	}
+	_, err = tx.Exec("DELETE FROM outbox_publications WHERE event_id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to clear outbox event %d publications: %v", id, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit outbox event %d delivery: %v", id, err)
+	}
+	return nil
+}
+
+func (repo *OutboxRepository_Impl) MarkFailed(id int64, nextAttemptAt time.Time, lastError string) error {
+	_, err := repo.DB.Conn.Exec("UPDATE outbox_events SET attempts = attempts + 1, next_attempt_at = ?, last_error = ? WHERE id = ?", nextAttemptAt.UTC(), lastError, id)
+	if err != nil {
+		return fmt.Errorf("failed to record outbox event %d failure: %v", id, err)
+	}
+	return nil
+}
+
+func (repo *OutboxRepository_Impl) PurgeDelivered(before time.Time) (int64, error) {
+	result, err := repo.DB.Conn.Exec("DELETE FROM outbox_events WHERE delivered_at IS NOT NULL AND delivered_at < ?", before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge delivered outbox events: %v", err)
+	}
+	return result.RowsAffected()
+}
+
+func (repo *OutboxRepository_Impl) PublishedTargets(eventID int64) ([]string, error) {
+	rows, err := repo.DB.Conn.Query("SELECT target FROM outbox_publications WHERE event_id = ?", eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox event %d publications: %v", eventID, err)
+	}
+	defer rows.Close()
+
+	var targets []string
+	for rows.Next() {
+		var target string
+		if err := rows.Scan(&target); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox publication row: %v", err)
+		}
+		targets = append(targets, target)
+	}
+	return targets, rows.Err()
+}
+
+func (repo *OutboxRepository_Impl) MarkPublished(eventID int64, target string, at time.Time) error {
+	_, err := repo.DB.Conn.Exec("INSERT OR IGNORE INTO outbox_publications (event_id, target, published_at) VALUES (?, ?, ?)", eventID, target, at.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to record outbox event %d publication to %s: %v", eventID, target, err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import "time"
+
+type OutboxRecord struct {
+	ID          int64
+	EventType   string
+	AggregateID string
+	Payload     []byte
+	Attempts    int
+	CreatedAt   time.Time
+}
+
+type OutboxRepository interface {
+	PendingEvents(now time.Time, limit int) ([]*OutboxRecord, error)
+	MarkDelivered(id int64, deliveredAt time.Time) error
+	MarkFailed(id int64, nextAttemptAt time.Time, lastError string) error
+	PurgeDelivered(before time.Time) (int64, error)
+	PublishedTargets(eventID int64) ([]string, error)
+	MarkPublished(eventID int64, target string, at time.Time) error
+}
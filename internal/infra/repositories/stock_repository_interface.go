@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type StockRepository interface {
+	GetStock(itemID uuid.UUID, now time.Time) (*entities.Stock, error)
+	AdjustStock(itemID uuid.UUID, kind entities.MovementKind, delta int, reason string, actor string, at time.Time) (*entities.Stock, error)
+	CorrectStock(itemID uuid.UUID, onHand int, reason string, actor string, at time.Time) (*entities.Stock, error)
+	ListMovements(itemID uuid.UUID, limit int) ([]*entities.StockMovement, error)
+	Reserve(reservation *entities.Reservation) error
+	GetReservation(id uuid.UUID, now time.Time) (*entities.Reservation, error)
+	ReleaseReservation(id uuid.UUID, now time.Time) error
+	CommitReservation(id uuid.UUID, actor string, at time.Time) (*entities.Stock, error)
+}
@@ -0,0 +1,329 @@
+package repositories
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+	database "github.com/afornagieri/go_api_template/internal/infra/database"
+)
+
+const deliveryColumns = "id, webhook_id, event_id, event_type, payload, status, attempts, response_status, last_error, next_attempt_at, created_at, updated_at"
+
+type WebhookRepository_Impl struct {
+	DB *database.SqlCli
+}
+
+func NewWebhookRepository(db *database.SqlCli) *WebhookRepository_Impl {
+	return &WebhookRepository_Impl{DB: db}
+}
+
+func (repo *WebhookRepository_Impl) ListWebhooks() ([]*webhook.Webhook, error) {
+	var hooks []*webhook.Webhook
+
+	rows, err := repo.DB.Conn.Query("SELECT id, url, events, secret, active, created_at FROM webhooks ORDER BY created_at, id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhooks: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		hook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, hook)
+	}
+
+	return hooks, rows.Err()
+}
+
+func (repo *WebhookRepository_Impl) GetWebhook(id uuid.UUID) (*webhook.Webhook, error) {
+	row := repo.DB.Conn.QueryRow("SELECT id, url, events, secret, active, created_at FROM webhooks WHERE id = ?", id.String())
+	hook, err := scanWebhook(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("webhook '%s' not found: %w", id, webhook.ErrWebhookNotFound)
+	}
+	return hook, err
+}
+
+func (repo *WebhookRepository_Impl) CreateWebhook(hook *webhook.Webhook) error {
+	subscribed, err := json.Marshal(hook.Events)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook events: %v", err)
+	}
+
+	_, err = repo.DB.Conn.Exec("INSERT INTO webhooks (id, url, events, secret, active, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		hook.ID.String(), hook.URL, string(subscribed), hook.Secret, hook.Active, hook.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook: %v", err)
+	}
+	return nil
+}
+
+func (repo *WebhookRepository_Impl) UpdateWebhook(hook *webhook.Webhook) error {
+	subscribed, err := json.Marshal(hook.Events)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook events: %v", err)
+	}
+
+	res, err := repo.DB.Conn.Exec("UPDATE webhooks SET url = ?, events = ?, secret = ?, active = ? WHERE id = ?",
+		hook.URL, string(subscribed), hook.Secret, hook.Active, hook.ID.String())
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %v", err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %v", err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("webhook '%s' not found: %w", hook.ID, webhook.ErrWebhookNotFound)
+	}
+	return nil
+}
+
+func (repo *WebhookRepository_Impl) DeleteWebhook(id uuid.UUID) error {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	res, err := tx.Exec("DELETE FROM webhooks WHERE id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %v", err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %v", err)
+	}
+	if deleted == 0 {
+		err = fmt.Errorf("webhook '%s' not found: %w", id, webhook.ErrWebhookNotFound)
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM webhook_deliveries WHERE webhook_id = ?", id.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %v", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit webhook delete: %v", err)
+	}
+	return nil
+}
+
+func (repo *WebhookRepository_Impl) EnqueueDeliveries(eventID int64, eventType string, payload []byte, now time.Time) (int, error) {
+	tx, err := repo.DB.Conn.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	rows, err := tx.Query("SELECT id, url, events, secret, active, created_at FROM webhooks WHERE active = 1")
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch webhooks: %v", err)
+	}
+	var targets []uuid.UUID
+	for rows.Next() {
+		var hook *webhook.Webhook
+		hook, err = scanWebhook(rows)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		if hook.Matches(eventType) {
+			targets = append(targets, hook.ID)
+		}
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to fetch webhooks: %v", err)
+	}
+
+	now = now.UTC()
+	enqueued := 0
+	for _, target := range targets {
+		var res sql.Result
+		res, err = tx.Exec(`INSERT INTO webhook_deliveries (id, webhook_id, event_id, event_type, payload, status, next_attempt_at, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT(webhook_id, event_id) DO NOTHING`,
+			uuid.New().String(), target.String(), eventID, eventType, payload, string(webhook.DeliveryPending), now, now, now)
+		if err != nil {
+			return 0, fmt.Errorf("failed to enqueue webhook delivery: %v", err)
+		}
+		var inserted int64
+		inserted, err = res.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to enqueue webhook delivery: %v", err)
+		}
+		enqueued += int(inserted)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to commit webhook deliveries: %v", err)
+	}
+	return enqueued, nil
+}
+
+func (repo *WebhookRepository_Impl) DueDeliveries(now time.Time, limit int) ([]*DueDelivery, error) {
+	var due []*DueDelivery
+
+	rows, err := repo.DB.Conn.Query(`SELECT d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.response_status, d.last_error, d.next_attempt_at, d.created_at, d.updated_at, w.url, w.secret
+		FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+		WHERE d.status = ? AND d.next_attempt_at <= ?
+		ORDER BY d.next_attempt_at, d.event_id LIMIT ?`, string(webhook.DeliveryPending), now.UTC(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch due webhook deliveries: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item DueDelivery
+		var delivery webhook.Delivery
+		var nextAttemptAt time.Time
+
+		err := rows.Scan(&delivery.ID, &delivery.WebhookID, &delivery.EventID, &delivery.EventType, &delivery.Payload, &delivery.Status,
+			&delivery.Attempts, &delivery.ResponseStatus, &delivery.LastError, &nextAttemptAt, &delivery.CreatedAt, &delivery.UpdatedAt,
+			&item.URL, &item.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery row: %v", err)
+		}
+
+		delivery.NextAttemptAt = &nextAttemptAt
+		item.Delivery = &delivery
+		due = append(due, &item)
+	}
+
+	return due, rows.Err()
+}
+
+func (repo *WebhookRepository_Impl) MarkDelivered(id uuid.UUID, responseStatus int, at time.Time) error {
+	_, err := repo.DB.Conn.Exec("UPDATE webhook_deliveries SET status = ?, attempts = attempts + 1, response_status = ?, last_error = '', updated_at = ? WHERE id = ?",
+		string(webhook.DeliveryDelivered), responseStatus, at.UTC(), id.String())
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook delivery %s delivered: %v", id, err)
+	}
+	return nil
+}
+
+func (repo *WebhookRepository_Impl) PurgeDelivered(before time.Time) (int64, error) {
+	result, err := repo.DB.Conn.Exec("DELETE FROM webhook_deliveries WHERE status = ? AND updated_at < ?", string(webhook.DeliveryDelivered), before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge delivered webhook deliveries: %v", err)
+	}
+	return result.RowsAffected()
+}
+
+func (repo *WebhookRepository_Impl) MarkFailed(id uuid.UUID, responseStatus int, lastError string, nextAttemptAt time.Time, at time.Time) error {
+	_, err := repo.DB.Conn.Exec("UPDATE webhook_deliveries SET attempts = attempts + 1, response_status = ?, last_error = ?, next_attempt_at = ?, updated_at = ? WHERE id = ?",
+		responseStatus, lastError, nextAttemptAt.UTC(), at.UTC(), id.String())
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery %s failure: %v", id, err)
+	}
+	return nil
+}
+
+func (repo *WebhookRepository_Impl) MarkDead(id uuid.UUID, responseStatus int, lastError string, at time.Time) error {
+	_, err := repo.DB.Conn.Exec("UPDATE webhook_deliveries SET status = ?, attempts = attempts + 1, response_status = ?, last_error = ?, updated_at = ? WHERE id = ?",
+		string(webhook.DeliveryDead), responseStatus, lastError, at.UTC(), id.String())
+	if err != nil {
+		return fmt.Errorf("failed to dead-letter webhook delivery %s: %v", id, err)
+	}
+	return nil
+}
+
+func (repo *WebhookRepository_Impl) ListDeliveries(webhookID uuid.UUID, limit int) ([]*webhook.Delivery, error) {
+	return repo.queryDeliveries("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE webhook_id = ? ORDER BY created_at DESC, event_id DESC LIMIT ?", webhookID.String(), limit)
+}
+
+func (repo *WebhookRepository_Impl) ListDeadLetters(limit int) ([]*webhook.Delivery, error) {
+	return repo.queryDeliveries("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE status = ? ORDER BY updated_at DESC LIMIT ?", string(webhook.DeliveryDead), limit)
+}
+
+func (repo *WebhookRepository_Impl) RequeueDelivery(id uuid.UUID, at time.Time) (*webhook.Delivery, error) {
+	res, err := repo.DB.Conn.Exec("UPDATE webhook_deliveries SET status = ?, attempts = 0, next_attempt_at = ?, updated_at = ? WHERE id = ? AND status = ?",
+		string(webhook.DeliveryPending), at.UTC(), at.UTC(), id.String(), string(webhook.DeliveryDead))
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue webhook delivery: %v", err)
+	}
+	requeued, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue webhook delivery: %v", err)
+	}
+	if requeued == 0 {
+		return nil, fmt.Errorf("dead-lettered delivery '%s' not found: %w", id, webhook.ErrDeliveryNotFound)
+	}
+
+	deliveries, err := repo.queryDeliveries("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE id = ?", id.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, fmt.Errorf("delivery '%s' not found: %w", id, webhook.ErrDeliveryNotFound)
+	}
+	return deliveries[0], nil
+}
+
+func (repo *WebhookRepository_Impl) queryDeliveries(query string, args ...any) ([]*webhook.Delivery, error) {
+	var deliveries []*webhook.Delivery
+
+	rows, err := repo.DB.Conn.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook deliveries: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var delivery webhook.Delivery
+		var nextAttemptAt time.Time
+
+		err := rows.Scan(&delivery.ID, &delivery.WebhookID, &delivery.EventID, &delivery.EventType, &delivery.Payload, &delivery.Status,
+			&delivery.Attempts, &delivery.ResponseStatus, &delivery.LastError, &nextAttemptAt, &delivery.CreatedAt, &delivery.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery row: %v", err)
+		}
+
+		if delivery.Status == webhook.DeliveryPending {
+			delivery.NextAttemptAt = &nextAttemptAt
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWebhook(row rowScanner) (*webhook.Webhook, error) {
+	var hook webhook.Webhook
+	var subscribed string
+
+	err := row.Scan(&hook.ID, &hook.URL, &subscribed, &hook.Secret, &hook.Active, &hook.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan webhook row: %v", err)
+	}
+	if err := json.Unmarshal([]byte(subscribed), &hook.Events); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook events: %v", err)
+	}
+	return &hook, nil
+}
@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+)
+
+type DueDelivery struct {
+	Delivery *webhook.Delivery
+	URL      string
+	Secret   string
+}
+
+type WebhookRepository interface {
+	ListWebhooks() ([]*webhook.Webhook, error)
+	GetWebhook(id uuid.UUID) (*webhook.Webhook, error)
+	CreateWebhook(hook *webhook.Webhook) error
+	UpdateWebhook(hook *webhook.Webhook) error
+	DeleteWebhook(id uuid.UUID) error
+	EnqueueDeliveries(eventID int64, eventType string, payload []byte, now time.Time) (int, error)
+	DueDeliveries(now time.Time, limit int) ([]*DueDelivery, error)
+	MarkDelivered(id uuid.UUID, responseStatus int, at time.Time) error
+	MarkFailed(id uuid.UUID, responseStatus int, lastError string, nextAttemptAt time.Time, at time.Time) error
+	MarkDead(id uuid.UUID, responseStatus int, lastError string, at time.Time) error
+	ListDeliveries(webhookID uuid.UUID, limit int) ([]*webhook.Delivery, error)
+	ListDeadLetters(limit int) ([]*webhook.Delivery, error)
+	RequeueDelivery(id uuid.UUID, at time.Time) (*webhook.Delivery, error)
+	PurgeDelivered(before time.Time) (int64, error)
+}
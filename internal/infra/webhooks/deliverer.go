@@ -0,0 +1,143 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/infra/outbox"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+const maxResponseBody = 64 << 10
+
+type Deliverer struct {
+	Repo          repositories.WebhookRepository
+	Client        *http.Client
+	BatchSize     int
+	PollInterval  time.Duration
+	MaxAttempts   int
+	Retention     time.Duration
+	PurgeInterval time.Duration
+	Backoff       func(attempts int) time.Duration
+	Now           func() time.Time
+}
+
+func NewDeliverer(repo repositories.WebhookRepository) *Deliverer {
+	return &Deliverer{
+		Repo:          repo,
+		Client:        &http.Client{Timeout: 10 * time.Second},
+		BatchSize:     50,
+		PollInterval:  time.Second,
+		MaxAttempts:   8,
+		Retention:     30 * 24 * time.Hour,
+		PurgeInterval: time.Hour,
+		Backoff:       outbox.ExponentialBackoff(30*time.Second, time.Hour),
+		Now:           time.Now,
+	}
+}
+
+func (d *Deliverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+
+	var purgedAt time.Time
+	for {
+		if d.Retention > 0 && d.Now().Sub(purgedAt) >= d.PurgeInterval {
+			if _, err := d.PurgeDelivered(); err != nil {
+				log.Printf("Failed to purge delivered webhooks: %v", err)
+			}
+			purgedAt = d.Now()
+		}
+
+		sent, err := d.DeliverDue(ctx)
+		if err != nil {
+			log.Printf("Failed to deliver webhooks: %v", err)
+		}
+		if err == nil && sent == d.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Deliverer) DeliverDue(ctx context.Context) (int, error) {
+	due, err := d.Repo.DueDeliveries(d.Now(), d.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, item := range due {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		if err := d.deliver(ctx, item); err != nil {
+			return i, err
+		}
+	}
+	return len(due), nil
+}
+
+func (d *Deliverer) PurgeDelivered() (int64, error) {
+	if d.Retention <= 0 {
+		return 0, nil
+	}
+	return d.Repo.PurgeDelivered(d.Now().Add(-d.Retention))
+}
+
+func (d *Deliverer) deliver(ctx context.Context, item *repositories.DueDelivery) error {
+	delivery := item.Delivery
+	status, sendErr := d.send(ctx, item)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	now := d.Now()
+
+	if sendErr == nil {
+		return d.Repo.MarkDelivered(delivery.ID, status, now)
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= d.MaxAttempts {
+		return d.Repo.MarkDead(delivery.ID, status, sendErr.Error(), now)
+	}
+	return d.Repo.MarkFailed(delivery.ID, status, sendErr.Error(), now.Add(d.Backoff(attempts)), now)
+}
+
+func (d *Deliverer) send(ctx context.Context, item *repositories.DueDelivery) (int, error) {
+	delivery := item.Delivery
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, item.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := d.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "items-api-webhooks/1.0")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(item.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
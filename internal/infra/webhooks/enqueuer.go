@@ -0,0 +1,36 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/infra/outbox"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type Payload struct {
+	EventID    int64           `json:"event_id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type Enqueuer struct {
+	Repo repositories.WebhookRepository
+	Now  func() time.Time
+}
+
+func NewEnqueuer(repo repositories.WebhookRepository) *Enqueuer {
+	return &Enqueuer{Repo: repo, Now: time.Now}
+}
+
+func (e *Enqueuer) Publish(ctx context.Context, msg outbox.Message) error {
+	body, err := json.Marshal(Payload{EventID: msg.ID, Type: msg.Type, OccurredAt: msg.OccurredAt, Data: msg.Payload})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %v", err)
+	}
+	_, err = e.Repo.EnqueueDeliveries(msg.ID, msg.Type, body, e.Now())
+	return err
+}
@@ -0,0 +1,86 @@
+package blob_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+	"github.com/afornagieri/go_api_template/internal/infra/blob"
+	"github.com/afornagieri/go_api_template/internal/infra/outbox"
+)
+
+func TestFileSystem_ShouldStoreOpenAndDeleteBlobs(t *testing.T) {
+	store, err := blob.NewFileSystem(t.TempDir())
+	require.NoError(t, err)
+
+	size, err := store.Put("item/photo", strings.NewReader("content"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), size)
+
+	content, err := store.Open("item/photo")
+	require.NoError(t, err)
+	read, err := io.ReadAll(content)
+	content.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(read))
+
+	require.NoError(t, store.Delete("item/photo"))
+	require.NoError(t, store.Delete("item/photo"))
+	_, err = store.Open("item/photo")
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestFileSystem_ShouldNotLeavePartialBlobsBehind(t *testing.T) {
+	root := t.TempDir()
+	store, err := blob.NewFileSystem(root)
+	require.NoError(t, err)
+
+	_, err = store.Put("item/photo", io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(io.ErrUnexpectedEOF)))
+	assert.Error(t, err)
+
+	entries, err := os.ReadDir(filepath.Join(root, "item"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestFileSystem_ShouldRejectKeysOutsideTheRoot(t *testing.T) {
+	store, err := blob.NewFileSystem(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "../escape", "/etc/passwd", "item/../../escape", "item//photo"} {
+		_, err := store.Put(key, strings.NewReader("content"))
+		assert.Error(t, err, key)
+		assert.Error(t, store.DeletePrefix(key), key)
+	}
+}
+
+func TestCleaner_ShouldDeleteBlobsOfDeletedItems(t *testing.T) {
+	store, err := blob.NewFileSystem(t.TempDir())
+	require.NoError(t, err)
+	deleted, kept := uuid.New().String(), uuid.New().String()
+	for _, key := range []string{deleted + "/a", deleted + "/b", kept + "/a"} {
+		_, err := store.Put(key, strings.NewReader("content"))
+		require.NoError(t, err)
+	}
+	cleaner := blob.NewCleaner(store)
+
+	require.NoError(t, cleaner.Publish(context.Background(), outbox.Message{Type: string(events.ItemUpdated), AggregateID: kept}))
+	require.NoError(t, cleaner.Publish(context.Background(), outbox.Message{Type: string(events.ItemDeleted), AggregateID: deleted}))
+
+	_, err = store.Open(deleted + "/a")
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	_, err = store.Open(deleted + "/b")
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	content, err := store.Open(kept + "/a")
+	require.NoError(t, err)
+	content.Close()
+}
@@ -0,0 +1,56 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/afornagieri/go_api_template/internal/infra/cache"
+)
+
+func TestLRU_ShouldEvictLeastRecentlyUsed(t *testing.T) {
+	lru := cache.NewLRU(2)
+	lru.Set("a", []byte("1"), 0)
+	lru.Set("b", []byte("2"), 0)
+	lru.Get("a")
+
+	lru.Set("c", []byte("3"), 0)
+
+	_, found, _ := lru.Get("b")
+	assert.False(t, found)
+	value, found, _ := lru.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, []byte("1"), value)
+	assert.Equal(t, 2, lru.Len())
+	assert.Equal(t, uint64(1), lru.Evictions())
+}
+
+func TestLRU_ShouldExpireEntries(t *testing.T) {
+	lru := cache.NewLRU(10)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lru.Now = func() time.Time { return now }
+	lru.Set("a", []byte("1"), time.Minute)
+
+	now = now.Add(59 * time.Second)
+	_, found, _ := lru.Get("a")
+	assert.True(t, found)
+
+	now = now.Add(time.Second)
+	_, found, _ = lru.Get("a")
+	assert.False(t, found)
+	assert.Equal(t, 0, lru.Len())
+}
+
+func TestLRU_ShouldDeleteAndOverwrite(t *testing.T) {
+	lru := cache.NewLRU(10)
+	lru.Set("a", []byte("1"), 0)
+	lru.Set("a", []byte("2"), 0)
+	lru.Set("b", []byte("3"), 0)
+
+	value, _, _ := lru.Get("a")
+	assert.Equal(t, []byte("2"), value)
+
+	lru.Delete("a", "b", "missing")
+	assert.Equal(t, 0, lru.Len())
+}
@@ -0,0 +1,170 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func setupAttachments(t *testing.T) (*chi.Mux, *usecases.AttachmentUseCase_Impl, *mocks.MockBlobStore) {
+	itemRepo := mocks.NewMockItemRepository()
+	_, err := usecases.NewItemUseCase(itemRepo).CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	require.NoError(t, err)
+
+	blobs := mocks.NewMockBlobStore()
+	useCase := usecases.NewAttachmentUseCase(mocks.NewMockAttachmentRepository(), itemRepo, blobs)
+	ctrl := controllers.NewAttachmentController(useCase)
+
+	r := chi.NewRouter()
+	r.Get("/items/{name}/attachments", ctrl.ListAttachments)
+	r.Post("/items/{name}/attachments", ctrl.UploadAttachment)
+	r.Get("/items/{name}/attachments/{id}", ctrl.GetAttachment)
+	r.Get("/items/{name}/attachments/{id}/content", ctrl.DownloadAttachment)
+	r.Delete("/items/{name}/attachments/{id}", ctrl.DeleteAttachment)
+	return r, useCase, blobs
+}
+
+func uploadRequest(t *testing.T, url string, field string, filename string, content []byte) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writer.WriteField("note", "ignored"))
+	part, err := writer.CreateFormFile(field, filename)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", url, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func upload(t *testing.T, r http.Handler, filename string, content []byte) entities.Attachment {
+	req := uploadRequest(t, "/items/item1/attachments", "file", filename, content)
+	req.Header.Set("X-Actor", "alice")
+	response := httptest.NewRecorder()
+	r.ServeHTTP(response, req)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+
+	var attachment entities.Attachment
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&attachment))
+	assert.Equal(t, "/items/item1/attachments/"+attachment.ID.String(), response.Header().Get("Location"))
+	return attachment
+}
+
+func serve(r http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	response := httptest.NewRecorder()
+	r.ServeHTTP(response, req)
+	return response
+}
+
+func TestUploadAttachmentController_ShouldSniffContentAndRecordMetadata(t *testing.T) {
+	r, _, _ := setupAttachments(t)
+	content := append(append([]byte{}, pngHeader...), bytes.Repeat([]byte{0}, 100)...)
+
+	attachment := upload(t, r, `C:\photos\front.png`, content)
+
+	assert.Equal(t, "front.png", attachment.Filename)
+	assert.Equal(t, "image/png", attachment.ContentType)
+	assert.Equal(t, int64(len(content)), attachment.Size)
+	assert.Len(t, attachment.Checksum, 64)
+	assert.Equal(t, "alice", attachment.CreatedBy)
+
+	response := executeWebhookRequest(r, "GET", "/items/item1/attachments", "")
+	require.Equal(t, http.StatusOK, response.Code)
+	var attachments []entities.Attachment
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&attachments))
+	require.Len(t, attachments, 1)
+	assert.Equal(t, attachment.ID, attachments[0].ID)
+}
+
+func TestUploadAttachmentController_ShouldRejectInvalidUploads(t *testing.T) {
+	r, useCase, blobs := setupAttachments(t)
+	useCase.MaxSize = 64
+
+	for _, tc := range []struct {
+		name   string
+		req    *http.Request
+		status int
+	}{
+		{"disallowed type", uploadRequest(t, "/items/item1/attachments", "file", "page.png", []byte("<html><script>alert(1)</script></html>")), http.StatusUnsupportedMediaType},
+		{"too large", uploadRequest(t, "/items/item1/attachments", "file", "big.txt", bytes.Repeat([]byte("a"), 65)), http.StatusRequestEntityTooLarge},
+		{"empty file", uploadRequest(t, "/items/item1/attachments", "file", "empty.txt", nil), http.StatusUnprocessableEntity},
+		{"missing file part", uploadRequest(t, "/items/item1/attachments", "upload", "notes.txt", []byte("notes")), http.StatusBadRequest},
+		{"unknown item", uploadRequest(t, "/items/item2/attachments", "file", "notes.txt", []byte("notes")), http.StatusNotFound},
+	} {
+		assert.Equal(t, tc.status, serve(r, tc.req).Code, tc.name)
+	}
+
+	req := httptest.NewRequest("POST", "/items/item1/attachments", strings.NewReader("notes"))
+	req.Header.Set("Content-Type", "text/plain")
+	assert.Equal(t, http.StatusUnsupportedMediaType, serve(r, req).Code)
+	assert.Empty(t, blobs.Keys())
+}
+
+func TestDownloadAttachmentController_ShouldServeRangesAndValidators(t *testing.T) {
+	r, _, _ := setupAttachments(t)
+	content := []byte("0123456789 spec sheet")
+	attachment := upload(t, r, "spec.txt", content)
+	url := "/items/item1/attachments/" + attachment.ID.String() + "/content"
+
+	response := executeWebhookRequest(r, "GET", url, "")
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, content, response.Body.Bytes())
+	assert.Equal(t, "text/plain; charset=utf-8", response.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=spec.txt`, response.Header().Get("Content-Disposition"))
+	assert.Equal(t, "bytes", response.Header().Get("Accept-Ranges"))
+	etag := response.Header().Get("ETag")
+	assert.Equal(t, `"`+attachment.Checksum+`"`, etag)
+
+	req := httptest.NewRequest("GET", url, nil)
+	req.Header.Set("Range", "bytes=2-5")
+	response = serve(r, req)
+	assert.Equal(t, http.StatusPartialContent, response.Code)
+	assert.Equal(t, "2345", response.Body.String())
+	assert.Equal(t, "bytes 2-5/21", response.Header().Get("Content-Range"))
+
+	req = httptest.NewRequest("GET", url, nil)
+	req.Header.Set("Range", "bytes=100-")
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, serve(r, req).Code)
+
+	req = httptest.NewRequest("GET", url, nil)
+	req.Header.Set("Range", "bytes=0-3")
+	req.Header.Set("If-Range", `"stale"`)
+	response = serve(r, req)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, content, response.Body.Bytes())
+
+	req = httptest.NewRequest("GET", url, nil)
+	req.Header.Set("If-None-Match", etag)
+	assert.Equal(t, http.StatusNotModified, serve(r, req).Code)
+}
+
+func TestDeleteAttachmentController_ShouldRemoveMetadataAndBlob(t *testing.T) {
+	r, _, blobs := setupAttachments(t)
+	attachment := upload(t, r, "spec.pdf", []byte("%PDF-1.7\n"))
+	assert.Equal(t, "application/pdf", attachment.ContentType)
+	require.Len(t, blobs.Keys(), 1)
+
+	url := "/items/item1/attachments/" + attachment.ID.String()
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", url, "").Code)
+	assert.Empty(t, blobs.Keys())
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "GET", url, "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "DELETE", url, "").Code)
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/items/item1/attachments/not-a-uuid/content", "").Code)
+}
@@ -0,0 +1,141 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+func setupCategories() (*chi.Mux, *usecases.ItemUseCase_Impl) {
+	itemRepo := mocks.NewMockItemRepository()
+	ctrl := controllers.NewCategoryController(usecases.NewCategoryUseCase(mocks.NewMockCategoryRepository(itemRepo), itemRepo))
+	r := chi.NewRouter()
+	r.Get("/categories", ctrl.ListCategories)
+	r.Post("/categories", ctrl.CreateCategory)
+	r.Get("/categories/{id}", ctrl.GetCategory)
+	r.Put("/categories/{id}", ctrl.UpdateCategory)
+	r.Delete("/categories/{id}", ctrl.DeleteCategory)
+	r.Get("/categories/{id}/items", ctrl.ListItems)
+	r.Put("/categories/{id}/items/{name}", ctrl.AddItem)
+	r.Delete("/categories/{id}/items/{name}", ctrl.RemoveItem)
+	return r, usecases.NewItemUseCase(itemRepo)
+}
+
+func createCategory(t *testing.T, r http.Handler, body string) category.Category {
+	response := executeWebhookRequest(r, "POST", "/categories", body)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	var c category.Category
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&c))
+	return c
+}
+
+func itemNames(t *testing.T, r http.Handler, url string) []string {
+	response := executeWebhookRequest(r, "GET", url, "")
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	var items []*entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&items))
+	names := []string{}
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestCategoryController_ShouldCreateHierarchy(t *testing.T) {
+	r, _ := setupCategories()
+
+	root := createCategory(t, r, `{"name":" Electronics "}`)
+	response := executeWebhookRequest(r, "POST", "/categories", `{"name":"Phones","parent_id":"`+root.ID.String()+`"}`)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	var child category.Category
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&child))
+	assert.Equal(t, "/categories/"+child.ID.String(), response.Header().Get("Location"))
+	assert.Equal(t, "Electronics", root.Name)
+	assert.Nil(t, root.ParentID)
+	assert.Equal(t, root.ID, *child.ParentID)
+
+	response = executeWebhookRequest(r, "GET", "/categories/"+child.ID.String(), "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Body.String(), `"parent_id":"`+root.ID.String()+`"`)
+}
+
+func TestCategoryController_ShouldRejectInvalidCategories(t *testing.T) {
+	r, _ := setupCategories()
+	createCategory(t, r, `{"name":"Electronics"}`)
+
+	for body, status := range map[string]int{
+		`{"name":"  "}`: http.StatusUnprocessableEntity,
+		`{"name":"Toys","parent_id":"9b2f4c1e-5d3a-4f6b-8c7d-0e1f2a3b4c5d"}`: http.StatusUnprocessableEntity,
+		`{"name":"Electronics"}`: http.StatusConflict,
+	} {
+		response := executeWebhookRequest(r, "POST", "/categories", body)
+		assert.Equal(t, status, response.Code, body)
+	}
+}
+
+func TestCategoryController_UpdateShouldPreventCycles(t *testing.T) {
+	r, _ := setupCategories()
+	root := createCategory(t, r, `{"name":"Electronics"}`)
+	child := createCategory(t, r, `{"name":"Phones","parent_id":"`+root.ID.String()+`"}`)
+	grandchild := createCategory(t, r, `{"name":"Android","parent_id":"`+child.ID.String()+`"}`)
+
+	response := executeWebhookRequest(r, "PUT", "/categories/"+root.ID.String(), `{"name":"Electronics","parent_id":"`+grandchild.ID.String()+`"}`)
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = executeWebhookRequest(r, "PUT", "/categories/"+root.ID.String(), `{"name":"Electronics","parent_id":"`+root.ID.String()+`"}`)
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = executeWebhookRequest(r, "PUT", "/categories/"+grandchild.ID.String(), `{"name":"Android"}`)
+	assert.Equal(t, http.StatusOK, response.Code)
+	var moved category.Category
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&moved))
+	assert.Nil(t, moved.ParentID)
+}
+
+func TestCategoryController_ListItemsShouldIncludeDescendants(t *testing.T) {
+	r, items := setupCategories()
+	root := createCategory(t, r, `{"name":"Electronics"}`)
+	child := createCategory(t, r, `{"name":"Phones","parent_id":"`+root.ID.String()+`"}`)
+	items.CreateItem(&entities.Item{Name: "tv", Price: 500, Description: "Television"})
+	items.CreateItem(&entities.Item{Name: "phone", Price: 300, Description: "Smartphone"})
+
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "PUT", "/categories/"+root.ID.String()+"/items/tv", "").Code)
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "PUT", "/categories/"+child.ID.String()+"/items/phone", "").Code)
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "PUT", "/categories/"+child.ID.String()+"/items/phone", "").Code)
+
+	assert.Equal(t, []string{"phone", "tv"}, itemNames(t, r, "/categories/"+root.ID.String()+"/items"))
+	assert.Equal(t, []string{"tv"}, itemNames(t, r, "/categories/"+root.ID.String()+"/items?descendants=false"))
+	assert.Equal(t, []string{"phone"}, itemNames(t, r, "/categories/"+child.ID.String()+"/items"))
+
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/categories/"+root.ID.String()+"/items?descendants=maybe", "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "PUT", "/categories/"+root.ID.String()+"/items/radio", "").Code)
+}
+
+func TestCategoryController_DeleteShouldRequireEmptyCategory(t *testing.T) {
+	r, items := setupCategories()
+	root := createCategory(t, r, `{"name":"Electronics"}`)
+	child := createCategory(t, r, `{"name":"Phones","parent_id":"`+root.ID.String()+`"}`)
+	items.CreateItem(&entities.Item{Name: "phone", Price: 300, Description: "Smartphone"})
+	executeWebhookRequest(r, "PUT", "/categories/"+child.ID.String()+"/items/phone", "")
+
+	assert.Equal(t, http.StatusConflict, executeWebhookRequest(r, "DELETE", "/categories/"+root.ID.String(), "").Code)
+	assert.Equal(t, http.StatusConflict, executeWebhookRequest(r, "DELETE", "/categories/"+child.ID.String(), "").Code)
+
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/categories/"+child.ID.String()+"/items/phone", "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "DELETE", "/categories/"+child.ID.String()+"/items/phone", "").Code)
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/categories/"+child.ID.String(), "").Code)
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/categories/"+root.ID.String(), "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "GET", "/categories/"+root.ID.String(), "").Code)
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/categories/not-a-uuid", "").Code)
+}
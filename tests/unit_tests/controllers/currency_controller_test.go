@@ -0,0 +1,159 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+func setupCurrency(t *testing.T) (*chi.Mux, *usecases.CurrencyUseCase_Impl) {
+	itemRepo := mocks.NewMockItemRepository()
+	itemUseCase := usecases.NewItemUseCase(itemRepo)
+	for _, item := range []*entities.Item{
+		{Name: "item1", Price: 10.0, Description: "Description1"},
+		{Name: "item2", Price: 19.99, Currency: "eur", Description: "Description2"},
+	} {
+		_, err := itemUseCase.CreateItem(item)
+		require.NoError(t, err)
+	}
+
+	useCase := usecases.NewCurrencyUseCase(mocks.NewMockCurrencyRepository(), itemRepo)
+	itemCtrl := controllers.NewItemController(itemUseCase)
+	itemCtrl.Currencies = useCase
+	ctrl := controllers.NewCurrencyController(useCase)
+
+	r := chi.NewRouter()
+	r.Get("/items", itemCtrl.GetItems)
+	r.Get("/items/{name}", itemCtrl.GetItemByName)
+	r.Get("/exchange-rates", ctrl.ListRates)
+	r.Put("/exchange-rates/{currency}", ctrl.SetRate)
+	r.Delete("/exchange-rates/{currency}", ctrl.DeleteRate)
+	r.Get("/items/{name}/currency-prices", ctrl.ListItemPrices)
+	r.Put("/items/{name}/currency-prices/{currency}", ctrl.SetItemPrice)
+	r.Delete("/items/{name}/currency-prices/{currency}", ctrl.DeleteItemPrice)
+	return r, useCase
+}
+
+func listedPrices(t *testing.T, r http.Handler, url string) map[string]entities.CurrencyPrice {
+	response := executeWebhookRequest(r, "GET", url, "")
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	var items []*entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&items))
+	prices := map[string]entities.CurrencyPrice{}
+	for _, item := range items {
+		prices[item.Name] = entities.CurrencyPrice{Currency: item.Currency, Price: item.Price}
+	}
+	return prices
+}
+
+func TestCurrencyController_ShouldShowStoredCurrencies(t *testing.T) {
+	r, _ := setupCurrency(t)
+
+	assert.Equal(t, map[string]entities.CurrencyPrice{
+		"item1": {Currency: "USD", Price: 10.0},
+		"item2": {Currency: "EUR", Price: 19.99},
+	}, listedPrices(t, r, "/items"))
+}
+
+func TestCurrencyController_ShouldConvertAndRoundPrices(t *testing.T) {
+	r, _ := setupCurrency(t)
+	require.Equal(t, http.StatusOK, executeWebhookRequest(r, "PUT", "/exchange-rates/eur", `{"rate":0.92}`).Code)
+	require.Equal(t, http.StatusOK, executeWebhookRequest(r, "PUT", "/exchange-rates/JPY", `{"rate":151.237}`).Code)
+
+	assert.Equal(t, map[string]entities.CurrencyPrice{
+		"item1": {Currency: "EUR", Price: 9.2},
+		"item2": {Currency: "EUR", Price: 19.99},
+	}, listedPrices(t, r, "/items?currency=eur"))
+	assert.Equal(t, map[string]entities.CurrencyPrice{
+		"item1": {Currency: "USD", Price: 10.0},
+		"item2": {Currency: "USD", Price: 21.73},
+	}, listedPrices(t, r, "/items?currency=USD"))
+	assert.Equal(t, map[string]entities.CurrencyPrice{
+		"item1": {Currency: "JPY", Price: 1512},
+		"item2": {Currency: "JPY", Price: 3286},
+	}, listedPrices(t, r, "/items?currency=JPY"))
+
+	response := executeWebhookRequest(r, "GET", "/items/item1?currency=JPY", "")
+	require.Equal(t, http.StatusOK, response.Code)
+	var item entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&item))
+	assert.Equal(t, 1512.0, item.Price)
+	assert.Equal(t, "JPY", item.Currency)
+
+	response = executeWebhookRequest(r, "GET", "/items/item1", "")
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&item))
+	assert.Equal(t, 10.0, item.Price)
+	assert.Equal(t, "USD", item.Currency)
+}
+
+func TestCurrencyController_ShouldRejectUnknownCurrencies(t *testing.T) {
+	r, _ := setupCurrency(t)
+
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/items?currency=GBP", "").Code)
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/items?currency=euro", "").Code)
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/items/item1?currency=GBP", "").Code)
+}
+
+func TestCurrencyController_ShouldPreferFixedItemPrices(t *testing.T) {
+	r, _ := setupCurrency(t)
+	require.Equal(t, http.StatusOK, executeWebhookRequest(r, "PUT", "/exchange-rates/EUR", `{"rate":0.92}`).Code)
+
+	response := executeWebhookRequest(r, "PUT", "/items/item1/currency-prices/eur", `{"price":9.499}`)
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	var price entities.CurrencyPrice
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&price))
+	assert.Equal(t, entities.CurrencyPrice{Currency: "EUR", Price: 9.5}, price)
+
+	assert.Equal(t, 9.5, listedPrices(t, r, "/items?currency=EUR")["item1"].Price)
+
+	response = executeWebhookRequest(r, "GET", "/items/item1/currency-prices", "")
+	var prices []entities.CurrencyPrice
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&prices))
+	assert.Equal(t, []entities.CurrencyPrice{{Currency: "EUR", Price: 9.5}}, prices)
+
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/items/item1/currency-prices/EUR", "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "DELETE", "/items/item1/currency-prices/EUR", "").Code)
+	assert.Equal(t, 9.2, listedPrices(t, r, "/items?currency=EUR")["item1"].Price)
+
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "PUT", "/items/missing/currency-prices/EUR", `{"price":1}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, executeWebhookRequest(r, "PUT", "/items/item1/currency-prices/JPY", `{"price":0.4}`).Code)
+}
+
+func TestCurrencyController_ShouldManageExchangeRates(t *testing.T) {
+	r, useCase := setupCurrency(t)
+
+	loaded, err := useCase.LoadRates(strings.NewReader(`{"gbp":0.79,"EUR":0.92}`))
+	require.NoError(t, err)
+	assert.Equal(t, 2, loaded)
+	_, err = useCase.LoadRates(strings.NewReader(`{"EUR":-1}`))
+	assert.ErrorIs(t, err, entities.ErrInvalidItem)
+
+	response := executeWebhookRequest(r, "GET", "/exchange-rates", "")
+	var rates []entities.ExchangeRate
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&rates))
+	require.Len(t, rates, 2)
+	assert.Equal(t, "EUR", rates[0].Currency)
+	assert.Equal(t, "GBP", rates[1].Currency)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, executeWebhookRequest(r, "PUT", "/exchange-rates/USD", `{"rate":1}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, executeWebhookRequest(r, "PUT", "/exchange-rates/EUR", `{"rate":0}`).Code)
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/exchange-rates/gbp", "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "DELETE", "/exchange-rates/GBP", "").Code)
+}
+
+func TestRoundPrice_ShouldRoundHalfAwayFromZeroToMinorUnits(t *testing.T) {
+	assert.Equal(t, 1.01, entities.RoundPrice(1.005, "EUR"))
+	assert.Equal(t, 2.68, entities.RoundPrice(2.675, "USD"))
+	assert.Equal(t, 125.0, entities.RoundPrice(124.5, "JPY"))
+	assert.Equal(t, 1.235, entities.RoundPrice(1.2345, "KWD"))
+}
@@ -0,0 +1,69 @@
+package controller_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func streamEvents(ctrl *controllers.EventsController, lastEventID string) *httptest.ResponseRecorder {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest("GET", "/items/events", nil).WithContext(ctx)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	recorder := httptest.NewRecorder()
+	ctrl.StreamItemEvents(recorder, req)
+	return recorder
+}
+
+func TestEventsController_ShouldReplayEventsAfterLastEventID(t *testing.T) {
+	useCase := usecases.NewItemUseCase(mocks.NewMockItemRepository())
+	ctrl := controllers.NewEventsController(useCase.Events)
+	useCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	useCase.UpdateItem("item1", &entities.Item{Name: "item1", Price: 20.0, Description: "Description1"})
+	useCase.DeleteItem("item1")
+
+	response := streamEvents(ctrl, "1")
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "text/event-stream", response.Header().Get("Content-Type"))
+	body := response.Body.String()
+	assert.NotContains(t, body, "event: item.created")
+	assert.NotContains(t, body, "event: reset")
+	assert.Contains(t, body, "id: 2\nevent: item.updated\ndata: {")
+	assert.Contains(t, body, "id: 3\nevent: item.deleted\ndata: {")
+	assert.Less(t, strings.Index(body, "id: 2"), strings.Index(body, "id: 3"))
+}
+
+func TestEventsController_ShouldSendResetWhenEventsWereEvicted(t *testing.T) {
+	bus := events.NewBusWithReplay(2)
+	ctrl := controllers.NewEventsController(bus)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		bus.Publish(events.ItemCreated, &entities.Item{Name: name})
+	}
+
+	body := streamEvents(ctrl, "1").Body.String()
+
+	assert.Contains(t, body, "event: reset")
+	assert.Contains(t, body, "id: 3\n")
+	assert.Contains(t, body, "id: 4\n")
+}
+
+func TestEventsController_ShouldRejectInvalidLastEventID(t *testing.T) {
+	ctrl := controllers.NewEventsController(events.NewBus())
+
+	response := streamEvents(ctrl, "abc")
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
@@ -0,0 +1,174 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type graphQLResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func setupGraphQL() (*controllers.GraphQLController, *mocks.MockItemRepository) {
+	mockRepo := mocks.NewMockItemRepository()
+	return controllers.NewGraphQLController(usecases.NewItemUseCase(mockRepo)), mockRepo
+}
+
+func executeGraphQL(ctrl *controllers.GraphQLController, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/graphql", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	ctrl.Query(recorder, req)
+	return recorder
+}
+
+func TestGraphQLController_ItemByName(t *testing.T) {
+	ctrl, mockRepo := setupGraphQL()
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	response := executeGraphQL(ctrl, `{"query":"{ item(name: \"item1\") { name price } }"}`)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	var result graphQLResponse
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"name": "item1", "price": 10.0}, result.Data["item"])
+}
+
+func TestGraphQLController_ItemsConnection(t *testing.T) {
+	ctrl, mockRepo := setupGraphQL()
+	for _, name := range []string{"apple", "banana", "cherry"} {
+		mockRepo.CreateItem(&entities.Item{Name: name, Price: 10.0, Description: "Fruit"})
+	}
+
+	response := executeGraphQL(ctrl, `{"query":"{ items(first: 2, filter: {minPrice: 5}) { edges { node { name } } pageInfo { hasNextPage endCursor } } }"}`)
+
+	var result graphQLResponse
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Empty(t, result.Errors)
+	items := result.Data["items"].(map[string]any)
+	assert.Len(t, items["edges"], 2)
+	assert.Equal(t, true, items["pageInfo"].(map[string]any)["hasNextPage"])
+}
+
+func TestGraphQLController_CreateItemMutation(t *testing.T) {
+	ctrl, mockRepo := setupGraphQL()
+
+	response := executeGraphQL(ctrl, `{"query":"mutation($input: ItemInput!) { createItem(input: $input) { id name } }","variables":{"input":{"name":"item1","price":5,"description":"Description1"}}}`)
+
+	var result graphQLResponse
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Empty(t, result.Errors)
+	item, err := mockRepo.GetItemByName("item1")
+	assert.NoError(t, err)
+	assert.Equal(t, item.ID.String(), result.Data["createItem"].(map[string]any)["id"])
+}
+
+func TestGraphQLController_ShouldValidateItemInput(t *testing.T) {
+	ctrl, mockRepo := setupGraphQL()
+
+	response := executeGraphQL(ctrl, `{"query":"mutation($input: ItemInput!) { createItem(input: $input) { id } }","variables":{"input":{"name":"","price":0,"description":"Description1"}}}`)
+
+	var result graphQLResponse
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Len(t, result.Errors, 1)
+	assert.Equal(t, "name is required", result.Errors[0].Message)
+	items, _ := mockRepo.GetItems()
+	assert.Empty(t, items)
+}
+
+func TestGraphQLController_Batch(t *testing.T) {
+	ctrl, mockRepo := setupGraphQL()
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	mockRepo.CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2"})
+
+	response := executeGraphQL(ctrl, `[{"query":"{ item(name: \"item1\") { name } }"},{"query":"{ item(name: \"item2\") { name } }"}]`)
+
+	var results []graphQLResponse
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&results))
+	assert.Len(t, results, 2)
+	assert.Equal(t, "item2", results[1].Data["item"].(map[string]any)["name"])
+}
+
+func TestGraphQLController_ShouldRejectComplexQueries(t *testing.T) {
+	ctrl, _ := setupGraphQL()
+
+	response := executeGraphQL(ctrl, `{"query":"{ items(first: 100) { edges { node { id name price description } cursor } pageInfo { hasNextPage } } }"}`)
+
+	var result graphQLResponse
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[0].Message, "complexity")
+}
+
+func TestGraphQLController_ShouldServeGraphiQL(t *testing.T) {
+	ctrl, _ := setupGraphQL()
+
+	req := httptest.NewRequest("GET", "/graphql", nil)
+	req.Header.Set("Accept", "text/html")
+	recorder := httptest.NewRecorder()
+	ctrl.Query(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "graphiql")
+	assert.NotContains(t, recorder.Body.String(), "https://")
+}
+
+func TestGraphQLController_ItemsFilteredByTags(t *testing.T) {
+	ctrl, mockRepo := setupGraphQL()
+	for _, name := range []string{"apple", "banana", "cherry"} {
+		mockRepo.CreateItem(&entities.Item{Name: name, Price: 10.0, Description: "Fruit"})
+	}
+	useCase := usecases.NewItemUseCase(mockRepo)
+	useCase.AddTag("apple", "seasonal")
+	useCase.AddTag("cherry", "seasonal")
+	useCase.AddTag("cherry", "fragile")
+
+	response := executeGraphQL(ctrl, `{"query":"{ items(first: 10, filter: {tags: [\"Seasonal\", \"fragile\"]}) { edges { node { name tags } } } }"}`)
+
+	var result graphQLResponse
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Empty(t, result.Errors)
+	edges := result.Data["items"].(map[string]any)["edges"].([]any)
+	assert.Len(t, edges, 1)
+	assert.Equal(t, map[string]any{"name": "cherry", "tags": []any{"fragile", "seasonal"}}, edges[0].(map[string]any)["node"])
+}
+
+func TestGraphQLController_ItemsDefaultToActive(t *testing.T) {
+	ctrl, mockRepo := setupGraphQL()
+	mockRepo.CreateItem(&entities.Item{Name: "apple", Price: 10.0, Description: "Fruit", Status: entities.StatusActive})
+	mockRepo.CreateItem(&entities.Item{Name: "banana", Price: 10.0, Description: "Fruit", Status: entities.StatusDraft})
+
+	names := func(body string) []any {
+		var result graphQLResponse
+		assert.NoError(t, json.NewDecoder(executeGraphQL(ctrl, body).Body).Decode(&result))
+		assert.Empty(t, result.Errors)
+		var names []any
+		for _, edge := range result.Data["items"].(map[string]any)["edges"].([]any) {
+			names = append(names, edge.(map[string]any)["node"].(map[string]any)["name"])
+		}
+		return names
+	}
+
+	assert.Equal(t, []any{"apple"}, names(`{"query":"{ items(first: 10) { edges { node { name } } } }"}`))
+	assert.Equal(t, []any{"banana"}, names(`{"query":"{ items(first: 10, filter: {status: [\"draft\"]}) { edges { node { name } } } }"}`))
+	assert.Equal(t, []any{"apple", "banana"}, names(`{"query":"{ items(first: 10, filter: {status: [\"all\"]}) { edges { node { name } } } }"}`))
+
+	response := executeGraphQL(ctrl, `{"query":"{ item(name: \"banana\") { status } }"}`)
+	var result graphQLResponse
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"status": "draft"}, result.Data["item"])
+}
@@ -0,0 +1,55 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateItemController_ShouldRecordActorHeader(t *testing.T) {
+	ctrl, _ := setupController()
+
+	req, _ := http.NewRequest("POST", "/items", strings.NewReader(`{"name":"item1","price":10,"description":"Description1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "alice")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	var created entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&created))
+	assert.Equal(t, "alice", created.CreatedBy)
+	assert.Equal(t, "alice", created.UpdatedBy)
+	assert.False(t, created.CreatedAt.IsZero())
+}
+
+func TestGetItemsController_ShouldSortAndFilterByAuditFields(t *testing.T) {
+	ctrl, _ := setupController()
+	ctrl.UseCase.WithActor("alice").CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	ctrl.UseCase.WithActor("bob").CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2"})
+	ctrl.UseCase.WithActor("alice").CreateItem(&entities.Item{Name: "item3", Price: 30.0, Description: "Description3"})
+
+	req, _ := http.NewRequest("GET", "/items?created_by=alice&sort=-price", nil)
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	var items []*entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&items))
+	require.Len(t, items, 2)
+	assert.Equal(t, "item3", items[0].Name)
+	assert.Equal(t, "item1", items[1].Name)
+}
+
+func TestGetItemsController_ShouldRejectInvalidSortAndBounds(t *testing.T) {
+	ctrl, _ := setupController()
+
+	req, _ := http.NewRequest("GET", "/items?sort=colour", nil)
+	assert.Equal(t, http.StatusBadRequest, executeRequest(req, ctrl).Code)
+
+	req, _ = http.NewRequest("GET", "/items?created_after=yesterday", nil)
+	assert.Equal(t, http.StatusBadRequest, executeRequest(req, ctrl).Code)
+}
@@ -0,0 +1,97 @@
+package controller_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetItemsController_ShouldReturnNotModifiedForMatchingETag(t *testing.T) {
+	ctrl, mockRepo := setupController()
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	req, _ := http.NewRequest("GET", "/items", nil)
+	first := executeRequest(req, ctrl)
+	etag := first.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	assert.NotEmpty(t, first.Header().Get("Last-Modified"))
+
+	req, _ = http.NewRequest("GET", "/items", nil)
+	req.Header.Set("If-None-Match", etag)
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusNotModified, response.Code)
+	assert.Empty(t, response.Body.String())
+	assert.Equal(t, etag, response.Header().Get("ETag"))
+	assert.Equal(t, "Accept", response.Header().Get("Vary"))
+}
+
+func TestGetItemsController_ShouldChangeETagWhenCollectionChanges(t *testing.T) {
+	ctrl, mockRepo := setupController()
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	mockRepo.CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2"})
+
+	req, _ := http.NewRequest("GET", "/items", nil)
+	etag := executeRequest(req, ctrl).Header().Get("ETag")
+
+	mockRepo.DeleteItem("item1")
+
+	req, _ = http.NewRequest("GET", "/items", nil)
+	req.Header.Set("If-None-Match", etag)
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NotEqual(t, etag, response.Header().Get("ETag"))
+}
+
+func TestGetItemsController_ShouldVaryETagByRepresentation(t *testing.T) {
+	ctrl, _ := setupController()
+
+	req, _ := http.NewRequest("GET", "/items", nil)
+	req.Header.Set("Accept", "application/json")
+	jsonETag := executeRequest(req, ctrl).Header().Get("ETag")
+
+	req, _ = http.NewRequest("GET", "/items", nil)
+	req.Header.Set("Accept", "application/xml")
+	req.Header.Set("If-None-Match", jsonETag)
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NotEqual(t, jsonETag, response.Header().Get("ETag"))
+}
+
+func TestGetItemByNameController_ShouldHonourIfModifiedSince(t *testing.T) {
+	ctrl, _ := setupController()
+	ctrl.UseCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	req, _ := http.NewRequest("GET", "/items/item1", nil)
+	req.Header.Set("If-Modified-Since", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	response := executeRequest(req, ctrl)
+	assert.Equal(t, http.StatusNotModified, response.Code)
+
+	req, _ = http.NewRequest("GET", "/items/item1", nil)
+	req.Header.Set("If-Modified-Since", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	response = executeRequest(req, ctrl)
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestGetItemByNameController_ShouldPreferIfNoneMatchOverIfModifiedSince(t *testing.T) {
+	ctrl, _ := setupController()
+	ctrl.UseCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	req, _ := http.NewRequest("GET", "/items/item1", nil)
+	etag := executeRequest(req, ctrl).Header().Get("ETag")
+
+	ctrl.UseCase.UpdateItem("item1", &entities.Item{Name: "item1", Price: 15.0, Description: "Description1"})
+
+	req, _ = http.NewRequest("GET", "/items/item1", nil)
+	req.Header.Set("If-None-Match", etag)
+	req.Header.Set("If-Modified-Since", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NotEqual(t, etag, response.Header().Get("ETag"))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
@@ -11,6 +12,7 @@ import (
 	"github.com/afornagieri/go_api_template/internal/domain/usecases"
 	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,10 +28,32 @@ func executeRequest(req *http.Request, ctrl *controllers.ItemController) *httpte
 	router := chi.NewRouter()
 
 	router.Get("/items", ctrl.GetItems)
+	router.Get("/items/export", ctrl.ExportItems)
+	router.Post("/items/import", ctrl.ImportItems)
 	router.Get("/items/{name}", ctrl.GetItemByName)
 	router.Post("/items", ctrl.CreateItem)
 	router.Put("/items/{name}", ctrl.UpdateItem)
 	router.Delete("/items/{name}", ctrl.DeleteItem)
+	router.Post("/items/{name}/publish", ctrl.PublishItem)
+	router.Post("/items/{name}/unpublish", ctrl.UnpublishItem)
+	router.Post("/items/{name}/discontinue", ctrl.DiscontinueItem)
+	router.Post("/items/{name}/archive", ctrl.ArchiveItem)
+	router.Post("/items/{name}/restore", ctrl.RestoreItem)
+	router.Put("/items/{name}/tags/{tag}", ctrl.AddTag)
+	router.Delete("/items/{name}/tags/{tag}", ctrl.RemoveTag)
+	router.Get("/tags", ctrl.ListTags)
+	router.Get("/items/{name}/prices", ctrl.ListPriceChanges)
+	router.Post("/items/{name}/prices", ctrl.SchedulePriceChange)
+	router.Get("/items/{name}/prices/{id}", ctrl.GetPriceChange)
+	router.Delete("/items/{name}/prices/{id}", ctrl.CancelPriceChange)
+	router.Get("/items/{name}/translations", ctrl.ListTranslations)
+	router.Get("/items/{name}/translations/{locale}", ctrl.GetTranslation)
+	router.Put("/items/{name}/translations/{locale}", ctrl.SetTranslation)
+	router.Delete("/items/{name}/translations/{locale}", ctrl.DeleteTranslation)
+	router.Get("/items/{name}/variants", ctrl.ListVariants)
+	router.Get("/items/{name}/variants/{sku}", ctrl.GetVariant)
+	router.Put("/items/{name}/variants/{sku}", ctrl.PutVariant)
+	router.Delete("/items/{name}/variants/{sku}", ctrl.DeleteVariant)
 
 	router.ServeHTTP(recorder, req)
 
@@ -70,3 +94,79 @@ func TestGetItemsController_ShouldReturnError(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "internal server error", errResponse["error"])
 }
+
+func TestCreateItemController_ShouldReturnCreatedItem(t *testing.T) {
+	ctrl, _ := setupController()
+
+	body := `{"name":"item 1","price":10.0,"description":"Description1"}`
+	req, _ := http.NewRequest("POST", "/items", strings.NewReader(body))
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, "/items/item%201", response.Header().Get("Location"))
+	assert.Equal(t, "application/json", response.Header().Get("Content-Type"))
+
+	var item entities.Item
+	err := json.NewDecoder(response.Body).Decode(&item)
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, item.ID)
+	assert.Equal(t, "item 1", item.Name)
+}
+
+func TestUpdateItemController_ShouldReturnRepresentationWhenPreferred(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	created, _ := mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	body := `{"name":"item1","price":20.0,"description":"Updated"}`
+	req, _ := http.NewRequest("PUT", "/items/item1", strings.NewReader(body))
+	req.Header.Set("Prefer", "return=representation")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "return=representation", response.Header().Get("Preference-Applied"))
+
+	var item entities.Item
+	err := json.NewDecoder(response.Body).Decode(&item)
+	assert.NoError(t, err)
+	assert.Equal(t, created.ID, item.ID)
+	assert.Equal(t, 20.0, item.Price)
+}
+
+func TestUpdateItemController_ShouldReturnNoContentByDefault(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	body := `{"name":"item1","price":20.0,"description":"Updated"}`
+	req, _ := http.NewRequest("PUT", "/items/item1", strings.NewReader(body))
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusNoContent, response.Code)
+	assert.Empty(t, response.Body.String())
+}
+
+func TestCreateItemController_ShouldRejectDuplicateName(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	body := `{"name":"item1","price":20.0,"description":"Description2"}`
+	req, _ := http.NewRequest("POST", "/items", strings.NewReader(body))
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
+
+func TestUpdateItemController_ShouldRejectRenameOntoExistingName(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	mockRepo.CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2"})
+
+	body := `{"name":"item2","price":10.0,"description":"Description1"}`
+	req, _ := http.NewRequest("PUT", "/items/item1", strings.NewReader(body))
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+}
@@ -0,0 +1,115 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+func transition(t *testing.T, ctrl *controllers.ItemController, name string, action string, status int) *entities.Item {
+	req, _ := http.NewRequest("POST", "/items/"+name+"/"+action, nil)
+	req.Header.Set("X-Actor", "bob")
+	response := executeRequest(req, ctrl)
+	require.Equal(t, status, response.Code, response.Body.String())
+	if status != http.StatusOK {
+		return nil
+	}
+	var item entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&item))
+	return &item
+}
+
+func TestCreateItemController_ShouldDefaultToActiveAndAcceptDrafts(t *testing.T) {
+	ctrl, _ := setupController()
+
+	for body, status := range map[string]string{
+		`{"name":"item1","price":10,"description":"Description1"}`:                  entities.StatusActive,
+		`{"name":"item2","price":10,"description":"Description2","status":"Draft"}`: entities.StatusDraft,
+	} {
+		req, _ := http.NewRequest("POST", "/items", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		response := executeRequest(req, ctrl)
+		require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+		var item entities.Item
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&item))
+		assert.Equal(t, status, item.Status)
+	}
+
+	for _, body := range []string{
+		`{"name":"item3","price":10,"description":"Description3","status":"archived"}`,
+		`{"name":"item3","price":10,"description":"Description3","status":"sold"}`,
+	} {
+		req, _ := http.NewRequest("POST", "/items", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		assert.Equal(t, http.StatusUnprocessableEntity, executeRequest(req, ctrl).Code, body)
+	}
+}
+
+func TestTransitionItemController_ShouldFollowTheStateMachine(t *testing.T) {
+	ctrl, _ := setupController()
+	_, err := ctrl.UseCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1", Status: entities.StatusDraft})
+	require.NoError(t, err)
+
+	transition(t, ctrl, "item1", entities.ActionDiscontinue, http.StatusConflict)
+	transition(t, ctrl, "item1", entities.ActionRestore, http.StatusConflict)
+
+	item := transition(t, ctrl, "item1", entities.ActionPublish, http.StatusOK)
+	assert.Equal(t, entities.StatusActive, item.Status)
+	assert.Equal(t, "bob", item.UpdatedBy)
+
+	transition(t, ctrl, "item1", entities.ActionPublish, http.StatusConflict)
+	transition(t, ctrl, "item1", entities.ActionArchive, http.StatusConflict)
+	assert.Equal(t, entities.StatusDiscontinued, transition(t, ctrl, "item1", entities.ActionDiscontinue, http.StatusOK).Status)
+	assert.Equal(t, entities.StatusArchived, transition(t, ctrl, "item1", entities.ActionArchive, http.StatusOK).Status)
+	assert.Equal(t, entities.StatusDraft, transition(t, ctrl, "item1", entities.ActionRestore, http.StatusOK).Status)
+	assert.Equal(t, entities.StatusActive, transition(t, ctrl, "item1", entities.ActionPublish, http.StatusOK).Status)
+	assert.Equal(t, entities.StatusDraft, transition(t, ctrl, "item1", entities.ActionUnpublish, http.StatusOK).Status)
+
+	transition(t, ctrl, "missing", entities.ActionPublish, http.StatusNotFound)
+}
+
+func TestUpdateItemController_ShouldKeepStatusAndRefuseChangingIt(t *testing.T) {
+	ctrl, _ := setupController()
+	_, err := ctrl.UseCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1", Status: entities.StatusDraft})
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest("PUT", "/items/item1", strings.NewReader(`{"name":"item1","price":12,"description":"Description1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	require.Equal(t, http.StatusNoContent, executeRequest(req, ctrl).Code)
+	item, err := ctrl.UseCase.GetItemByName("item1")
+	require.NoError(t, err)
+	assert.Equal(t, entities.StatusDraft, item.Status)
+
+	req, _ = http.NewRequest("PUT", "/items/item1", strings.NewReader(`{"name":"item1","price":12,"description":"Description1","status":"active"}`))
+	req.Header.Set("Content-Type", "application/json")
+	assert.Equal(t, http.StatusConflict, executeRequest(req, ctrl).Code)
+}
+
+func TestGetItemsController_ShouldListActiveItemsByDefault(t *testing.T) {
+	ctrl, _ := setupController()
+	for name, status := range map[string]string{
+		"item1": entities.StatusActive,
+		"item2": entities.StatusDraft,
+		"item3": entities.StatusActive,
+	} {
+		_, err := ctrl.UseCase.CreateItem(&entities.Item{Name: name, Price: 10.0, Description: "Description", Status: status})
+		require.NoError(t, err)
+	}
+	transition(t, ctrl, "item3", entities.ActionDiscontinue, http.StatusOK)
+
+	assert.Equal(t, []string{"item1"}, listedNames(t, ctrl, "/items"))
+	assert.Equal(t, []string{"item2"}, listedNames(t, ctrl, "/items?status=draft"))
+	assert.Equal(t, []string{"item2", "item3"}, listedNames(t, ctrl, "/items?status=draft,discontinued"))
+	assert.Equal(t, []string{"item1", "item3"}, listedNames(t, ctrl, "/items?status=active&status=discontinued"))
+	assert.Equal(t, []string{"item1", "item2", "item3"}, listedNames(t, ctrl, "/items?status=all"))
+
+	req, _ := http.NewRequest("GET", "/items?status=sold", nil)
+	assert.Equal(t, http.StatusBadRequest, executeRequest(req, ctrl).Code)
+}
@@ -0,0 +1,143 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPricedItem(t *testing.T) (*controllers.ItemController, *usecases.ItemUseCase_Impl, *time.Time) {
+	ctrl, _ := setupController()
+	useCase := ctrl.UseCase.(*usecases.ItemUseCase_Impl)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	useCase.Now = func() time.Time { return now }
+
+	_, err := useCase.WithActor("alice").CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	require.NoError(t, err)
+	return ctrl, useCase, &now
+}
+
+func schedulePrice(t *testing.T, ctrl *controllers.ItemController, body string) entities.PriceChange {
+	req, _ := http.NewRequest("POST", "/items/item1/prices", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "bob")
+	response := executeRequest(req, ctrl)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	var change entities.PriceChange
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&change))
+	assert.Equal(t, "/items/item1/prices/"+change.ID.String(), response.Header().Get("Location"))
+	return change
+}
+
+func itemAt(t *testing.T, ctrl *controllers.ItemController, at string) *entities.Item {
+	req, _ := http.NewRequest("GET", "/items/item1?at="+at, nil)
+	response := executeRequest(req, ctrl)
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	var item entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&item))
+	return &item
+}
+
+func TestPriceHistoryController_ShouldRecordEveryPriceChange(t *testing.T) {
+	ctrl, useCase, now := setupPricedItem(t)
+	*now = now.Add(time.Hour)
+	useCase.UpdateItem("item1", &entities.Item{Name: "item1", Price: 12.0, Description: "Description1"})
+	*now = now.Add(time.Hour)
+	useCase.UpdateItem("item1", &entities.Item{Name: "item1", Price: 12.0, Description: "Changed"})
+
+	req, _ := http.NewRequest("GET", "/items/item1/prices", nil)
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	var changes []entities.PriceChange
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&changes))
+	require.Len(t, changes, 2)
+	assert.Equal(t, 12.0, changes[0].Price)
+	assert.Equal(t, 10.0, changes[1].Price)
+	assert.Equal(t, "alice", changes[1].CreatedBy)
+	assert.NotNil(t, changes[1].AppliedAt)
+}
+
+func TestGetItemController_ShouldReturnThePriceAtAPointInTime(t *testing.T) {
+	ctrl, useCase, now := setupPricedItem(t)
+	*now = now.Add(time.Hour)
+	useCase.UpdateItem("item1", &entities.Item{Name: "item1", Price: 12.0, Description: "Description1"})
+	schedulePrice(t, ctrl, `{"price":15,"effective_from":"2024-05-02T00:00:00Z"}`)
+
+	assert.Equal(t, 10.0, itemAt(t, ctrl, "2024-05-01T12:30:00Z").Price)
+	assert.Equal(t, 12.0, itemAt(t, ctrl, "2024-05-01T13:00:00Z").Price)
+	assert.Equal(t, 15.0, itemAt(t, ctrl, "2024-05-03T00:00:00Z").Price)
+
+	req, _ := http.NewRequest("GET", "/items/item1?at=2024-04-30T00:00:00Z", nil)
+	assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code)
+	req, _ = http.NewRequest("GET", "/items/item1?at=yesterday", nil)
+	assert.Equal(t, http.StatusBadRequest, executeRequest(req, ctrl).Code)
+}
+
+func TestSchedulePriceController_ShouldApplyOnceDue(t *testing.T) {
+	ctrl, useCase, now := setupPricedItem(t)
+	change := schedulePrice(t, ctrl, `{"price":15,"effective_from":"2024-05-01T13:00:00Z"}`)
+	assert.Nil(t, change.AppliedAt)
+	assert.Equal(t, "bob", change.CreatedBy)
+
+	applied, err := useCase.ApplyDuePriceChanges(10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, applied)
+
+	*now = now.Add(2 * time.Hour)
+	applied, err = useCase.ApplyDuePriceChanges(10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, applied)
+
+	item, _ := useCase.GetItemByName("item1")
+	assert.Equal(t, 15.0, item.Price)
+	assert.Equal(t, "bob", item.UpdatedBy)
+
+	req, _ := http.NewRequest("GET", "/items/item1/prices/"+change.ID.String(), nil)
+	response := executeRequest(req, ctrl)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&change))
+	assert.NotNil(t, change.AppliedAt)
+
+	req, _ = http.NewRequest("DELETE", "/items/item1/prices/"+change.ID.String(), nil)
+	assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code)
+}
+
+func TestSchedulePriceController_ShouldCancelPendingChanges(t *testing.T) {
+	ctrl, useCase, now := setupPricedItem(t)
+	change := schedulePrice(t, ctrl, `{"price":15,"effective_from":"2024-05-01T13:00:00Z"}`)
+
+	req, _ := http.NewRequest("DELETE", "/items/item1/prices/"+change.ID.String(), nil)
+	assert.Equal(t, http.StatusNoContent, executeRequest(req, ctrl).Code)
+
+	*now = now.Add(2 * time.Hour)
+	applied, err := useCase.ApplyDuePriceChanges(10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, applied)
+	assert.Equal(t, 10.0, itemAt(t, ctrl, "2024-05-01T14:00:00Z").Price)
+}
+
+func TestSchedulePriceController_ShouldRejectPastOrInvalidPrices(t *testing.T) {
+	ctrl, _, _ := setupPricedItem(t)
+
+	for _, body := range []string{
+		`{"price":15,"effective_from":"2024-05-01T11:00:00Z"}`,
+		`{"price":0,"effective_from":"2024-05-02T00:00:00Z"}`,
+	} {
+		req, _ := http.NewRequest("POST", "/items/item1/prices", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		assert.Equal(t, http.StatusUnprocessableEntity, executeRequest(req, ctrl).Code, body)
+	}
+
+	req, _ := http.NewRequest("POST", "/items/item2/prices", strings.NewReader(`{"price":15,"effective_from":"2024-05-02T00:00:00Z"}`))
+	req.Header.Set("Content-Type", "application/json")
+	assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code)
+}
@@ -0,0 +1,137 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type xmlItem struct {
+	XMLName xml.Name `xml:"item"`
+	ID      string   `xml:"id"`
+	Name    string   `xml:"name"`
+	Price   float64  `xml:"price"`
+	Status  string   `xml:"status"`
+	Tags    []string `xml:"tags>tag"`
+}
+
+func TestGetItemByNameController_XML(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	req, _ := http.NewRequest("GET", "/items/item1", nil)
+	req.Header.Set("Accept", "application/xml")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "application/xml", response.Header().Get("Content-Type"))
+
+	var item xmlItem
+	err := xml.NewDecoder(response.Body).Decode(&item)
+	assert.NoError(t, err)
+	assert.Equal(t, "item1", item.Name)
+	assert.Equal(t, 10.0, item.Price)
+	assert.Equal(t, "active", item.Status)
+}
+
+func TestCreateItemController_XML(t *testing.T) {
+	ctrl, _ := setupController()
+
+	body := `<item><name>item1</name><price>10.5</price><description>Description1</description><tags><tag>sale</tag><tag>new</tag></tags></item>`
+	req, _ := http.NewRequest("POST", "/items", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("Accept", "application/xml")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+
+	var item xmlItem
+	assert.NoError(t, xml.NewDecoder(response.Body).Decode(&item))
+	assert.Equal(t, "item1", item.Name)
+	assert.Equal(t, 10.5, item.Price)
+	assert.ElementsMatch(t, []string{"new", "sale"}, item.Tags)
+}
+
+func TestGetItemsController_XMLList(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	mockRepo.CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2"})
+
+	req, _ := http.NewRequest("GET", "/items", nil)
+	req.Header.Set("Accept", "text/xml")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	var list struct {
+		Items []xmlItem `xml:"item"`
+	}
+	err := xml.NewDecoder(response.Body).Decode(&list)
+	assert.NoError(t, err)
+	assert.Len(t, list.Items, 2)
+}
+
+func TestCreateItemController_MessagePack(t *testing.T) {
+	ctrl, _ := setupController()
+
+	var body bytes.Buffer
+	enc := msgpack.NewEncoder(&body)
+	enc.SetCustomStructTag("json")
+	enc.Encode(map[string]any{"name": "item1", "price": 10.0, "description": "Description1"})
+
+	req, _ := http.NewRequest("POST", "/items", &body)
+	req.Header.Set("Content-Type", "application/msgpack")
+	req.Header.Set("Accept", "application/msgpack")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, "application/msgpack", response.Header().Get("Content-Type"))
+
+	var item entities.Item
+	dec := msgpack.NewDecoder(response.Body)
+	dec.SetCustomStructTag("json")
+	assert.NoError(t, dec.Decode(&item))
+	assert.Equal(t, "item1", item.Name)
+}
+
+func TestGetItemsController_CSV(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	req, _ := http.NewRequest("GET", "/items", nil)
+	req.Header.Set("Accept", "application/json;q=0.5, text/csv")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "text/csv", response.Header().Get("Content-Type"))
+	assert.True(t, strings.HasPrefix(response.Body.String(), "id,name,price,currency,description,status,type,attributes\n"))
+}
+
+func TestGetItemsController_ShouldReturnNotAcceptable(t *testing.T) {
+	ctrl, _ := setupController()
+
+	req, _ := http.NewRequest("GET", "/items", nil)
+	req.Header.Set("Accept", "application/pdf")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusNotAcceptable, response.Code)
+}
+
+func TestCreateItemController_ShouldReturnUnsupportedMediaType(t *testing.T) {
+	ctrl, _ := setupController()
+
+	req, _ := http.NewRequest("POST", "/items", strings.NewReader("name=item1"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, response.Code)
+}
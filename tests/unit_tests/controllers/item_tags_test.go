@@ -0,0 +1,114 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTaggedItems(t *testing.T) *controllers.ItemController {
+	ctrl, _ := setupController()
+	tagged := map[string][]string{
+		"item1": {"seasonal", "fragile"},
+		"item2": {"seasonal"},
+		"item3": {"clearance"},
+	}
+	for _, name := range []string{"item1", "item2", "item3"} {
+		_, err := ctrl.UseCase.CreateItem(&entities.Item{Name: name, Price: 10.0, Description: "Description"})
+		require.NoError(t, err)
+		for _, tag := range tagged[name] {
+			_, err := ctrl.UseCase.AddTag(name, tag)
+			require.NoError(t, err)
+		}
+	}
+	return ctrl
+}
+
+func listedNames(t *testing.T, ctrl *controllers.ItemController, url string) []string {
+	req, _ := http.NewRequest("GET", url, nil)
+	response := executeRequest(req, ctrl)
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	var items []*entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&items))
+	names := []string{}
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestAddTagController_ShouldNormalizeAndStampItem(t *testing.T) {
+	ctrl, _ := setupController()
+	ctrl.UseCase.WithActor("alice").CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	req, _ := http.NewRequest("PUT", "/items/item1/tags/Seasonal", nil)
+	req.Header.Set("X-Actor", "bob")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	var tagged entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&tagged))
+	assert.Equal(t, []string{"seasonal"}, tagged.Tags)
+	assert.Equal(t, "bob", tagged.UpdatedBy)
+	assert.Equal(t, "alice", tagged.CreatedBy)
+
+	req, _ = http.NewRequest("PUT", "/items/item1/tags/seasonal", nil)
+	response = executeRequest(req, ctrl)
+	assert.Equal(t, http.StatusOK, response.Code)
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&tagged))
+	assert.Equal(t, []string{"seasonal"}, tagged.Tags)
+	assert.Equal(t, "bob", tagged.UpdatedBy)
+}
+
+func TestAddTagController_ShouldRejectInvalidTagsAndUnknownItems(t *testing.T) {
+	ctrl, _ := setupController()
+	ctrl.UseCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	req, _ := http.NewRequest("PUT", "/items/item1/tags/half%20price", nil)
+	assert.Equal(t, http.StatusUnprocessableEntity, executeRequest(req, ctrl).Code)
+
+	req, _ = http.NewRequest("PUT", "/items/item2/tags/seasonal", nil)
+	assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code)
+}
+
+func TestRemoveTagController_ShouldRemoveOnlyExistingTags(t *testing.T) {
+	ctrl := setupTaggedItems(t)
+
+	req, _ := http.NewRequest("DELETE", "/items/item1/tags/fragile", nil)
+	response := executeRequest(req, ctrl)
+	assert.Equal(t, http.StatusOK, response.Code)
+	var untagged entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&untagged))
+	assert.Equal(t, []string{"seasonal"}, untagged.Tags)
+
+	req, _ = http.NewRequest("DELETE", "/items/item1/tags/fragile", nil)
+	assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code)
+}
+
+func TestGetItemsController_ShouldFilterByTags(t *testing.T) {
+	ctrl := setupTaggedItems(t)
+
+	assert.Equal(t, []string{"item1", "item2"}, listedNames(t, ctrl, "/items?tag=seasonal"))
+	assert.Equal(t, []string{"item1"}, listedNames(t, ctrl, "/items?tag=seasonal&tag=FRAGILE"))
+	assert.Equal(t, []string{"item1", "item3"}, listedNames(t, ctrl, "/items?tag=fragile&tag=clearance&tag_match=any"))
+
+	req, _ := http.NewRequest("GET", "/items?tag=seasonal&tag_match=some", nil)
+	assert.Equal(t, http.StatusBadRequest, executeRequest(req, ctrl).Code)
+}
+
+func TestListTagsController_ShouldReturnUsageCounts(t *testing.T) {
+	ctrl := setupTaggedItems(t)
+
+	req, _ := http.NewRequest("GET", "/tags", nil)
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	var tags []entities.TagCount
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&tags))
+	assert.Equal(t, []entities.TagCount{{Tag: "seasonal", Count: 2}, {Tag: "clearance", Count: 1}, {Tag: "fragile", Count: 1}}, tags)
+}
@@ -0,0 +1,181 @@
+package controller_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportItemsController_CSV(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.5, Description: "Description1"})
+
+	req, _ := http.NewRequest("GET", "/items/export", nil)
+	req.Header.Set("Accept", "text/csv")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "text/csv", response.Header().Get("Content-Type"))
+
+	lines := strings.Split(strings.TrimSpace(response.Body.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Equal(t, "id,name,price,currency,description,status,type,attributes", lines[0])
+	assert.True(t, strings.HasSuffix(lines[1], ",item1,10.5,USD,Description1,active,,"))
+}
+
+func TestExportItemsController_NDJSON(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	mockRepo.CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2"})
+
+	req, _ := http.NewRequest("GET", "/items/export", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "application/x-ndjson", response.Header().Get("Content-Type"))
+
+	count := 0
+	scanner := bufio.NewScanner(response.Body)
+	for scanner.Scan() {
+		var item entities.Item
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &item))
+		count++
+	}
+	assert.Equal(t, 2, count)
+}
+
+func TestExportItemsController_ShouldFilterByStatus(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	mockRepo.CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2", Status: entities.StatusDraft})
+
+	exported := func(url string) int {
+		req, _ := http.NewRequest("GET", url, nil)
+		req.Header.Set("Accept", "application/x-ndjson")
+		response := executeRequest(req, ctrl)
+		assert.Equal(t, http.StatusOK, response.Code)
+		return strings.Count(response.Body.String(), "\n")
+	}
+
+	assert.Equal(t, 1, exported("/items/export"))
+	assert.Equal(t, 1, exported("/items/export?status=draft"))
+	assert.Equal(t, 2, exported("/items/export?status=all"))
+
+	req, _ := http.NewRequest("GET", "/items/export?status=retired", nil)
+	assert.Equal(t, http.StatusBadRequest, executeRequest(req, ctrl).Code)
+}
+
+func TestExportItemsController_ShouldRejectUnsupportedAccept(t *testing.T) {
+	ctrl, _ := setupController()
+
+	req, _ := http.NewRequest("GET", "/items/export", nil)
+	req.Header.Set("Accept", "application/pdf")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusNotAcceptable, response.Code)
+}
+
+func TestImportItemsController_CSV(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	body := "name,price,description\nitem1,10,Description1\nitem2,20,Description2\n"
+	req, _ := http.NewRequest("POST", "/items/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+
+	items, _ := mockRepo.GetItems()
+	assert.Len(t, items, 2)
+}
+
+func TestImportItemsController_ShouldRejectExistingNames(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	mockRepo.CreateItem(&entities.Item{Name: "item1", Price: 10, Description: "Description1"})
+
+	body := "name,price,description\nitem2,20,Description2\nitem1,10,Description1\n"
+	req, _ := http.NewRequest("POST", "/items/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	items, _ := mockRepo.GetItems()
+	assert.Len(t, items, 1)
+}
+
+func TestImportItemsController_ShouldReportRowErrors(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	body := "name,price,description\nitem1,10,Description1\nitem2,abc,Description2\n,5,Description3\n"
+	req, _ := http.NewRequest("POST", "/items/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+
+	var result struct {
+		Errors []struct {
+			Line  int    `json:"line"`
+			Error string `json:"error"`
+		} `json:"errors"`
+	}
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Len(t, result.Errors, 2)
+	assert.Equal(t, 3, result.Errors[0].Line)
+	assert.Equal(t, "invalid price 'abc'", result.Errors[0].Error)
+	assert.Equal(t, 4, result.Errors[1].Line)
+	assert.Equal(t, "name is required", result.Errors[1].Error)
+
+	items, _ := mockRepo.GetItems()
+	assert.Len(t, items, 0)
+}
+
+func TestImportItemsController_NDJSONRowErrors(t *testing.T) {
+	ctrl, _ := setupController()
+
+	body := `{"name":"item1","price":10,"description":"Description1"}` + "\n\n" + `{"name":"item2","price":0,"description":"Description2"}` + "\n"
+	req, _ := http.NewRequest("POST", "/items/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	assert.Contains(t, response.Body.String(), `"line":3`)
+	assert.Contains(t, response.Body.String(), "price must be greater than 0")
+}
+
+func TestImportItemsController_ShouldReportMalformedCSVRows(t *testing.T) {
+	ctrl, mockRepo := setupController()
+
+	body := "name,price,description\nitem1,10,Description1\na\"b,1,x\n\"abc,1,x\n"
+	req, _ := http.NewRequest("POST", "/items/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+	response := executeRequest(req, ctrl)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+
+	var result struct {
+		Errors []struct {
+			Line  int    `json:"line"`
+			Error string `json:"error"`
+		} `json:"errors"`
+	}
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Len(t, result.Errors, 2)
+	assert.Equal(t, 3, result.Errors[0].Line)
+	assert.Contains(t, result.Errors[0].Error, "bare \" in non-quoted-field")
+	assert.Equal(t, 4, result.Errors[1].Line)
+
+	items, _ := mockRepo.GetItems()
+	assert.Len(t, items, 0)
+}
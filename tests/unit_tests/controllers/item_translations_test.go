@@ -0,0 +1,155 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTranslatedItems(t *testing.T) *controllers.ItemController {
+	ctrl, _ := setupController()
+	for _, name := range []string{"item1", "item2"} {
+		_, err := ctrl.UseCase.CreateItem(&entities.Item{Name: name, Price: 10.0, Description: "Description"})
+		require.NoError(t, err)
+	}
+	_, err := ctrl.UseCase.SetTranslation("item1", "pt", entities.TranslationInput{Name: "Item um", Description: "Descrição"})
+	require.NoError(t, err)
+	_, err = ctrl.UseCase.SetTranslation("item2", "es", entities.TranslationInput{Name: "Artículo dos", Description: "Descripción"})
+	require.NoError(t, err)
+	return ctrl
+}
+
+func localizedRequest(url string, languages string) *http.Request {
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set("Accept-Language", languages)
+	return req
+}
+
+func TestSetTranslationController_ShouldNormalizeLocaleAndStampItem(t *testing.T) {
+	ctrl, _ := setupController()
+	ctrl.UseCase.WithActor("alice").CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	req, _ := http.NewRequest("PUT", "/items/item1/translations/pt_br", strings.NewReader(`{"name":"Item um","description":"Descrição"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "bob")
+	response := executeRequest(req, ctrl)
+
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	assert.Equal(t, "pt-BR", response.Header().Get("Content-Language"))
+	var translation entities.Translation
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&translation))
+	assert.Equal(t, "pt-BR", translation.Locale)
+	assert.Equal(t, "bob", translation.UpdatedBy)
+
+	item, err := ctrl.UseCase.GetItemByName("item1")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", item.UpdatedBy)
+}
+
+func TestSetTranslationController_ShouldRejectInvalidTranslations(t *testing.T) {
+	ctrl, _ := setupController()
+	ctrl.UseCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	for _, tc := range []struct {
+		url    string
+		body   string
+		status int
+	}{
+		{"/items/item1/translations/en", `{"name":"Item one","description":"Description"}`, http.StatusUnprocessableEntity},
+		{"/items/item1/translations/not-a-locale", `{"name":"Item","description":"Description"}`, http.StatusUnprocessableEntity},
+		{"/items/item1/translations/pt", `{"name":"","description":"Descrição"}`, http.StatusUnprocessableEntity},
+		{"/items/item2/translations/pt", `{"name":"Item dois","description":"Descrição"}`, http.StatusNotFound},
+	} {
+		req, _ := http.NewRequest("PUT", tc.url, strings.NewReader(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+		assert.Equal(t, tc.status, executeRequest(req, ctrl).Code, tc.url)
+	}
+}
+
+func TestGetItemByNameController_ShouldFallBackThroughLanguageChain(t *testing.T) {
+	ctrl := setupTranslatedItems(t)
+
+	response := executeRequest(localizedRequest("/items/item1", "pt-BR, es;q=0.8"), ctrl)
+
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	assert.Equal(t, "pt", response.Header().Get("Content-Language"))
+	assert.Contains(t, response.Header().Values("Vary"), "Accept-Language")
+	var item entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&item))
+	assert.Equal(t, "item1", item.Name)
+	assert.Equal(t, "Item um", item.DisplayName)
+	assert.Equal(t, "Descrição", item.Description)
+
+	response = executeRequest(localizedRequest("/items/item1", "fr"), ctrl)
+	assert.Equal(t, entities.DefaultLocale, response.Header().Get("Content-Language"))
+	var fallback entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&fallback))
+	assert.Empty(t, fallback.DisplayName)
+	assert.Equal(t, "Description", fallback.Description)
+}
+
+func TestGetItemsController_ShouldListLocalesUsed(t *testing.T) {
+	ctrl := setupTranslatedItems(t)
+
+	response := executeRequest(localizedRequest("/items", "pt, es;q=0.5"), ctrl)
+
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	assert.Equal(t, "pt, es", response.Header().Get("Content-Language"))
+	var items []*entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&items))
+	require.Len(t, items, 2)
+	assert.Equal(t, "Item um", items[0].DisplayName)
+	assert.Equal(t, "Artículo dos", items[1].DisplayName)
+}
+
+func TestGetItemByNameController_ShouldKeyETagsByLanguage(t *testing.T) {
+	ctrl := setupTranslatedItems(t)
+
+	english := executeRequest(localizedRequest("/items/item1", "en"), ctrl)
+	portuguese := executeRequest(localizedRequest("/items/item1", "pt"), ctrl)
+	assert.NotEqual(t, english.Header().Get("ETag"), portuguese.Header().Get("ETag"))
+
+	req := localizedRequest("/items/item1", "pt")
+	req.Header.Set("If-None-Match", portuguese.Header().Get("ETag"))
+	assert.Equal(t, http.StatusNotModified, executeRequest(req, ctrl).Code)
+}
+
+func TestListTranslationsController_ShouldListLocalesInContentLanguage(t *testing.T) {
+	ctrl := setupTranslatedItems(t)
+	_, err := ctrl.UseCase.SetTranslation("item1", "es", entities.TranslationInput{Name: "Artículo uno", Description: "Descripción"})
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest("GET", "/items/item1/translations", nil)
+	response := executeRequest(req, ctrl)
+
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "es, pt", response.Header().Get("Content-Language"))
+	var translations []*entities.Translation
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&translations))
+	require.Len(t, translations, 2)
+	assert.Equal(t, "es", translations[0].Locale)
+}
+
+func TestDeleteTranslationController_ShouldRemoveOnlyExistingTranslations(t *testing.T) {
+	ctrl := setupTranslatedItems(t)
+
+	req, _ := http.NewRequest("DELETE", "/items/item1/translations/pt", nil)
+	assert.Equal(t, http.StatusNoContent, executeRequest(req, ctrl).Code)
+
+	req, _ = http.NewRequest("GET", "/items/item1/translations/pt", nil)
+	assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code)
+
+	req, _ = http.NewRequest("DELETE", "/items/item1/translations/pt", nil)
+	assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code)
+
+	req, _ = http.NewRequest("GET", "/items/item1/translations", nil)
+	response := executeRequest(req, ctrl)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.JSONEq(t, "[]", response.Body.String())
+}
@@ -0,0 +1,254 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+const electronicsSchema = `{"schema":{"type":"object","properties":{
+	"voltage":{"type":"integer","minimum":0},
+	"plug":{"type":"string","enum":["eu","us","uk"]},
+	"wireless":{"type":"boolean"}
+},"required":["voltage"],"additionalProperties":false}}`
+
+func setupItemTypes(t *testing.T) *chi.Mux {
+	itemRepo := mocks.NewMockItemRepository()
+	typeRepo := mocks.NewMockItemTypeRepository(itemRepo)
+	itemUseCase := usecases.NewItemUseCase(itemRepo)
+	itemUseCase.Types = typeRepo
+	itemCtrl := controllers.NewItemController(itemUseCase)
+	ctrl := controllers.NewItemTypeController(usecases.NewItemTypeUseCase(typeRepo, itemRepo))
+
+	r := chi.NewRouter()
+	r.Get("/items", itemCtrl.GetItems)
+	r.Get("/items/{name}", itemCtrl.GetItemByName)
+	r.Post("/items", itemCtrl.CreateItem)
+	r.Put("/items/{name}", itemCtrl.UpdateItem)
+	r.Delete("/items/{name}", itemCtrl.DeleteItem)
+	r.Get("/items/export", itemCtrl.ExportItems)
+	r.Post("/items/import", itemCtrl.ImportItems)
+	r.Get("/item-types", ctrl.ListItemTypes)
+	r.Get("/item-types/{name}", ctrl.GetItemType)
+	r.Put("/item-types/{name}", ctrl.PutItemType)
+	r.Delete("/item-types/{name}", ctrl.DeleteItemType)
+
+	response := executeWebhookRequest(r, "PUT", "/item-types/Electronics", electronicsSchema)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	return r
+}
+
+func typedItemNames(t *testing.T, r http.Handler, url string) []string {
+	response := executeWebhookRequest(r, "GET", url, "")
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	var items []*entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&items))
+	names := []string{}
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestPutItemTypeController_ShouldCreateThenReplace(t *testing.T) {
+	r := setupItemTypes(t)
+
+	response := executeWebhookRequest(r, "GET", "/item-types/electronics", "")
+	require.Equal(t, http.StatusOK, response.Code)
+	var created entities.ItemType
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&created))
+	assert.Equal(t, "electronics", created.Name)
+	assert.NotContains(t, string(created.Schema), "\n")
+
+	response = executeWebhookRequest(r, "PUT", "/item-types/electronics", `{"description":"Gadgets","schema":{"type":"object"}}`)
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	var replaced entities.ItemType
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&replaced))
+	assert.Equal(t, "Gadgets", replaced.Description)
+	assert.Equal(t, created.CreatedAt, replaced.CreatedAt)
+}
+
+func TestPutItemTypeController_ShouldRejectUnsupportedSchemas(t *testing.T) {
+	r := setupItemTypes(t)
+
+	for _, body := range []string{
+		`{"schema":{"type":"string"}}`,
+		`{"schema":{"type":"object","properties":{"a":{"$ref":"#/defs/a"}}}}`,
+		`{"schema":{"type":"object","properties":{"a":{"type":"array"}}}}`,
+		`{"schema":{"type":"object","properties":{"a":{"type":"string","pattern":"("}}}}`,
+		`{"schema":{"type":"object","properties":{"a":{"type":"integer","enum":[1.5]}}}}`,
+		`{"schema":{"type":"object","required":["missing"]}}`,
+		`{"schema":{"type":"object","properties":{"bad-name":{"type":"string"}}}}`,
+	} {
+		response := executeWebhookRequest(r, "PUT", "/item-types/broken", body)
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code, body)
+	}
+	assert.Equal(t, http.StatusUnprocessableEntity, executeWebhookRequest(r, "PUT", "/item-types/1st", `{"schema":{"type":"object"}}`).Code)
+}
+
+func TestCreateItemController_ShouldValidateAttributesAgainstType(t *testing.T) {
+	r := setupItemTypes(t)
+
+	response := executeWebhookRequest(r, "POST", "/items", `{"name":"lamp","price":10,"description":"Lamp","type":"Electronics","attributes":{"voltage":230, "plug":"eu"}}`)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	var item entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&item))
+	assert.Equal(t, "electronics", item.Type)
+	assert.JSONEq(t, `{"voltage":230,"plug":"eu"}`, string(item.Attributes))
+
+	for _, body := range []string{
+		`{"name":"radio","price":10,"description":"Radio","type":"electronics","attributes":{"voltage":"high"}}`,
+		`{"name":"radio","price":10,"description":"Radio","type":"electronics","attributes":{"voltage":1.5}}`,
+		`{"name":"radio","price":10,"description":"Radio","type":"electronics","attributes":{"voltage":12,"plug":"jp"}}`,
+		`{"name":"radio","price":10,"description":"Radio","type":"electronics","attributes":{"voltage":12,"colour":"red"}}`,
+		`{"name":"radio","price":10,"description":"Radio","type":"electronics"}`,
+		`{"name":"radio","price":10,"description":"Radio","type":"furniture","attributes":{}}`,
+		`{"name":"radio","price":10,"description":"Radio","attributes":{"voltage":12}}`,
+	} {
+		response := executeWebhookRequest(r, "POST", "/items", body)
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code, body)
+	}
+}
+
+func TestUpdateItemController_ShouldKeepTypeAndAttributesWhenOmitted(t *testing.T) {
+	r := setupItemTypes(t)
+	require.Equal(t, http.StatusCreated, executeWebhookRequest(r, "POST", "/items", `{"name":"lamp","price":10,"description":"Lamp","type":"electronics","attributes":{"voltage":230}}`).Code)
+
+	response := executeWebhookRequest(r, "PUT", "/items/lamp", `{"name":"lamp","price":12,"description":"Lamp"}`)
+	require.Equal(t, http.StatusNoContent, response.Code, response.Body.String())
+	response = executeWebhookRequest(r, "GET", "/items/lamp", "")
+	require.Equal(t, http.StatusOK, response.Code)
+	var item entities.Item
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&item))
+	assert.Equal(t, "electronics", item.Type)
+	assert.JSONEq(t, `{"voltage":230}`, string(item.Attributes))
+
+	response = executeWebhookRequest(r, "PUT", "/items/lamp", `{"name":"lamp","price":12,"description":"Lamp","attributes":{"voltage":-1}}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func TestGetItemsController_ShouldFilterOnAttributes(t *testing.T) {
+	r := setupItemTypes(t)
+	for _, body := range []string{
+		`{"name":"lamp","price":10,"description":"Lamp","type":"electronics","attributes":{"voltage":230,"plug":"eu","wireless":false}}`,
+		`{"name":"radio","price":10,"description":"Radio","type":"electronics","attributes":{"voltage":12,"wireless":true}}`,
+		`{"name":"toaster","price":10,"description":"Toaster","type":"electronics","attributes":{"voltage":110,"plug":"us"}}`,
+		`{"name":"chair","price":10,"description":"Chair"}`,
+	} {
+		require.Equal(t, http.StatusCreated, executeWebhookRequest(r, "POST", "/items", body).Code, body)
+	}
+
+	assert.Equal(t, []string{"lamp", "radio", "toaster"}, typedItemNames(t, r, "/items?type=Electronics"))
+	assert.Equal(t, []string{"lamp"}, typedItemNames(t, r, "/items?attr.plug=eu"))
+	assert.Equal(t, []string{"radio"}, typedItemNames(t, r, "/items?attr.wireless=true"))
+	assert.Equal(t, []string{"lamp", "toaster"}, typedItemNames(t, r, "/items?attr.voltage.gte=110"))
+	assert.Equal(t, []string{"toaster"}, typedItemNames(t, r, "/items?attr.voltage.gt=12&attr.voltage.lt=230"))
+	assert.Equal(t, []string{"radio"}, typedItemNames(t, r, "/items?attr.voltage=12"))
+
+	for _, url := range []string{"/items?attr.voltage.gt=high", "/items?attr.voltage.between=1", "/items?attr.bad-name=1", "/items?type=1st"} {
+		assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", url, "").Code, url)
+	}
+}
+
+func TestPutItemTypeController_ShouldRefuseSchemasThatInvalidateItems(t *testing.T) {
+	r := setupItemTypes(t)
+	require.Equal(t, http.StatusCreated, executeWebhookRequest(r, "POST", "/items", `{"name":"lamp","price":10,"description":"Lamp","type":"electronics","attributes":{"voltage":230}}`).Code)
+
+	response := executeWebhookRequest(r, "PUT", "/item-types/electronics", `{"schema":{"type":"object","properties":{"voltage":{"type":"integer","maximum":120}}}}`)
+	assert.Equal(t, http.StatusConflict, response.Code, response.Body.String())
+	assert.Contains(t, response.Body.String(), "lamp")
+}
+
+func TestDeleteItemTypeController_ShouldRefuseTypesInUse(t *testing.T) {
+	r := setupItemTypes(t)
+	require.Equal(t, http.StatusCreated, executeWebhookRequest(r, "POST", "/items", `{"name":"lamp","price":10,"description":"Lamp","type":"electronics","attributes":{"voltage":230}}`).Code)
+
+	assert.Equal(t, http.StatusConflict, executeWebhookRequest(r, "DELETE", "/item-types/electronics", "").Code)
+	require.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/items/lamp", "").Code)
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/item-types/electronics", "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "DELETE", "/item-types/electronics", "").Code)
+	assert.JSONEq(t, "[]", executeWebhookRequest(r, "GET", "/item-types", "").Body.String())
+}
+
+func TestImportItemsController_ShouldReportAttributeErrors(t *testing.T) {
+	r := setupItemTypes(t)
+
+	body := "name,price,description,type,attributes\nlamp,10,Lamp,electronics,\"{\"\"voltage\"\":230}\"\nradio,10,Radio,electronics,\"{\"\"voltage\"\":\"\"x\"\"}\"\n"
+	req := httptest.NewRequest("POST", "/items/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+	response := httptest.NewRecorder()
+	r.ServeHTTP(response, req)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code, response.Body.String())
+	assert.Contains(t, response.Body.String(), "radio")
+	assert.Empty(t, typedItemNames(t, r, "/items"))
+}
+
+func TestItemTransfer_ShouldRoundTripStatusTypeAndAttributes(t *testing.T) {
+	for _, contentType := range []string{"text/csv", "application/x-ndjson"} {
+		t.Run(contentType, func(t *testing.T) {
+			source := setupItemTypes(t)
+			response := executeWebhookRequest(source, "POST", "/items", `{"name":"lamp","price":10,"description":"Lamp","status":"draft","type":"electronics","attributes":{"voltage":230,"plug":"eu"}}`)
+			require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+			response = executeWebhookRequest(source, "POST", "/items", `{"name":"radio","price":20,"description":"Radio"}`)
+			require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+
+			req := httptest.NewRequest("GET", "/items/export?status=all", nil)
+			req.Header.Set("Accept", contentType)
+			exported := httptest.NewRecorder()
+			source.ServeHTTP(exported, req)
+			require.Equal(t, http.StatusOK, exported.Code)
+
+			target := setupItemTypes(t)
+			req = httptest.NewRequest("POST", "/items/import", exported.Body)
+			req.Header.Set("Content-Type", contentType)
+			imported := httptest.NewRecorder()
+			target.ServeHTTP(imported, req)
+			require.Equal(t, http.StatusCreated, imported.Code, imported.Body.String())
+
+			var lamp entities.Item
+			response = executeWebhookRequest(target, "GET", "/items/lamp", "")
+			require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+			require.NoError(t, json.NewDecoder(response.Body).Decode(&lamp))
+			assert.Equal(t, entities.StatusDraft, lamp.Status)
+			assert.Equal(t, "electronics", lamp.Type)
+			assert.JSONEq(t, `{"voltage":230,"plug":"eu"}`, string(lamp.Attributes))
+
+			var radio entities.Item
+			response = executeWebhookRequest(target, "GET", "/items/radio", "")
+			require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+			require.NoError(t, json.NewDecoder(response.Body).Decode(&radio))
+			assert.Equal(t, entities.StatusActive, radio.Status)
+			assert.Empty(t, radio.Type)
+			assert.Empty(t, radio.Attributes)
+		})
+	}
+}
+
+func TestImportItemsController_NDJSONShouldValidateStatusAndAttributes(t *testing.T) {
+	r := setupItemTypes(t)
+
+	body := `{"name":"lamp","price":10,"description":"Lamp","status":"retired"}` + "\n" +
+		`{"name":"radio","price":10,"description":"Radio","type":"electronics","attributes":[230]}` + "\n"
+	req := httptest.NewRequest("POST", "/items/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	response := httptest.NewRecorder()
+	r.ServeHTTP(response, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code, response.Body.String())
+	assert.Contains(t, response.Body.String(), `"line":1`)
+	assert.Contains(t, response.Body.String(), "status 'retired' must be one of")
+	assert.Contains(t, response.Body.String(), `"line":2`)
+	assert.Contains(t, response.Body.String(), "attributes must be a JSON object")
+	assert.Empty(t, typedItemNames(t, r, "/items"))
+}
@@ -0,0 +1,120 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+func setupVariantItems(t *testing.T) *controllers.ItemController {
+	ctrl, _ := setupController()
+	for _, name := range []string{"shirt", "mug"} {
+		_, err := ctrl.UseCase.CreateItem(&entities.Item{Name: name, Price: 20.0, Description: "Description"})
+		require.NoError(t, err)
+	}
+	return ctrl
+}
+
+func putVariant(ctrl *controllers.ItemController, url string, body string) *http.Response {
+	req, _ := http.NewRequest("PUT", url, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "alice")
+	return executeRequest(req, ctrl).Result()
+}
+
+func TestPutVariantController_ShouldCreateThenReplace(t *testing.T) {
+	ctrl := setupVariantItems(t)
+
+	response := putVariant(ctrl, "/items/shirt/variants/ts-red-m", `{"options":[{"name":"Size","value":"M"},{"name":"colour","value":" red "}],"price":24.999}`)
+	require.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, "/items/shirt/variants/TS-RED-M", response.Header.Get("Location"))
+	var created entities.Variant
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&created))
+	assert.Equal(t, "TS-RED-M", created.SKU)
+	assert.Equal(t, []entities.VariantOption{{Name: "colour", Value: "red"}, {Name: "size", Value: "M"}}, created.Options)
+	assert.Equal(t, 25.0, created.EffectivePrice)
+	assert.Equal(t, "USD", created.Currency)
+	assert.Equal(t, "alice", created.CreatedBy)
+
+	response = putVariant(ctrl, "/items/shirt/variants/TS-RED-M", `{"options":[{"name":"size","value":"L"},{"name":"colour","value":"red"}]}`)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	var replaced entities.Variant
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&replaced))
+	assert.Equal(t, created.ID, replaced.ID)
+	assert.Nil(t, replaced.Price)
+	assert.Equal(t, 20.0, replaced.EffectivePrice)
+
+	item, err := ctrl.UseCase.GetItemByName("shirt")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", item.UpdatedBy)
+}
+
+func TestPutVariantController_ShouldEnforceSKUsAndDimensions(t *testing.T) {
+	ctrl := setupVariantItems(t)
+	require.Equal(t, http.StatusCreated, putVariant(ctrl, "/items/shirt/variants/TS-1", `{"options":[{"name":"size","value":"M"},{"name":"colour","value":"red"}]}`).StatusCode)
+
+	for url, body := range map[string]string{
+		"/items/shirt/variants/TS-2":   `{"options":[{"name":"size","value":"L"}]}`,
+		"/items/shirt/variants/TS-3":   `{"options":[{"name":"size","value":"L"},{"name":"fit","value":"slim"}]}`,
+		"/items/shirt/variants/TS-4":   `{"options":[]}`,
+		"/items/shirt/variants/TS-5":   `{"options":[{"name":"size","value":"L"},{"name":"size","value":"M"}]}`,
+		"/items/shirt/variants/TS-6":   `{"options":[{"name":"size","value":"L"},{"name":"colour","value":"red"}],"price":0}`,
+		"/items/shirt/variants/-BAD-":  `{"options":[{"name":"size","value":"L"},{"name":"colour","value":"red"}]}`,
+		"/items/shirt/variants/TS!7":   `{"options":[{"name":"size","value":"L"},{"name":"colour","value":"red"}]}`,
+		"/items/shirt/variants/TS-8":   `{"options":[{"name":"size","value":""},{"name":"colour","value":"red"}]}`,
+		"/items/shirt/variants/TS-9":   `{"options":[{"name":"1size","value":"L"},{"name":"colour","value":"red"}]}`,
+		"/items/shirt/variants/TS-10x": `{"options":[{"name":"size","value":"L"},{"name":"colour","value":"red"}],"price":0.001}`,
+	} {
+		assert.Equal(t, http.StatusUnprocessableEntity, putVariant(ctrl, url, body).StatusCode, url+" "+body)
+	}
+
+	assert.Equal(t, http.StatusConflict, putVariant(ctrl, "/items/shirt/variants/TS-2", `{"options":[{"name":"colour","value":"red"},{"name":"size","value":"M"}]}`).StatusCode)
+	assert.Equal(t, http.StatusConflict, putVariant(ctrl, "/items/mug/variants/ts-1", `{"options":[{"name":"capacity","value":"300ml"}]}`).StatusCode)
+	assert.Equal(t, http.StatusNotFound, putVariant(ctrl, "/items/missing/variants/TS-9", `{"options":[{"name":"size","value":"M"}]}`).StatusCode)
+	assert.Equal(t, http.StatusCreated, putVariant(ctrl, "/items/mug/variants/MUG-1", `{"options":[{"name":"capacity","value":"300ml"}]}`).StatusCode)
+}
+
+func TestVariantsController_ShouldListGetAndDelete(t *testing.T) {
+	ctrl := setupVariantItems(t)
+	require.Equal(t, http.StatusCreated, putVariant(ctrl, "/items/shirt/variants/TS-L", `{"options":[{"name":"size","value":"L"}],"price":22}`).StatusCode)
+	require.Equal(t, http.StatusCreated, putVariant(ctrl, "/items/shirt/variants/TS-M", `{"options":[{"name":"size","value":"M"}]}`).StatusCode)
+
+	req, _ := http.NewRequest("GET", "/items/shirt/variants", nil)
+	response := executeRequest(req, ctrl)
+	require.Equal(t, http.StatusOK, response.Code)
+	var variants []*entities.Variant
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&variants))
+	require.Len(t, variants, 2)
+	assert.Equal(t, "TS-L", variants[0].SKU)
+	assert.Equal(t, 22.0, variants[0].EffectivePrice)
+	assert.Equal(t, 20.0, variants[1].EffectivePrice)
+
+	req, _ = http.NewRequest("GET", "/items/mug/variants", nil)
+	response = executeRequest(req, ctrl)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.JSONEq(t, "[]", response.Body.String())
+
+	req, _ = http.NewRequest("GET", "/items/shirt/variants/ts-m", nil)
+	response = executeRequest(req, ctrl)
+	require.Equal(t, http.StatusOK, response.Code)
+	var variant entities.Variant
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&variant))
+	assert.Equal(t, "TS-M", variant.SKU)
+
+	for _, url := range []string{"/items/mug/variants/TS-M", "/items/missing/variants"} {
+		req, _ = http.NewRequest("GET", url, nil)
+		assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code, url)
+	}
+
+	req, _ = http.NewRequest("DELETE", "/items/shirt/variants/TS-M", nil)
+	assert.Equal(t, http.StatusNoContent, executeRequest(req, ctrl).Code)
+	req, _ = http.NewRequest("DELETE", "/items/shirt/variants/TS-M", nil)
+	assert.Equal(t, http.StatusNotFound, executeRequest(req, ctrl).Code)
+}
@@ -0,0 +1,171 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+func setupStock(t *testing.T) (*chi.Mux, *time.Time) {
+	itemRepo := mocks.NewMockItemRepository()
+	_, err := usecases.NewItemUseCase(itemRepo).CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	require.NoError(t, err)
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	useCase := usecases.NewStockUseCase(mocks.NewMockStockRepository(), itemRepo)
+	useCase.Now = func() time.Time { return now }
+	ctrl := controllers.NewStockController(useCase)
+
+	r := chi.NewRouter()
+	r.Get("/items/{name}/stock", ctrl.GetStock)
+	r.Post("/items/{name}/stock/receive", ctrl.Receive)
+	r.Post("/items/{name}/stock/ship", ctrl.Ship)
+	r.Post("/items/{name}/stock/correct", ctrl.Correct)
+	r.Get("/items/{name}/stock/movements", ctrl.ListMovements)
+	r.Post("/items/{name}/reservations", ctrl.Reserve)
+	r.Get("/reservations/{id}", ctrl.GetReservation)
+	r.Delete("/reservations/{id}", ctrl.ReleaseReservation)
+	r.Post("/reservations/{id}/commit", ctrl.CommitReservation)
+	return r, &now
+}
+
+func decodeStock(t *testing.T, r http.Handler, method string, url string, body string, status int) entities.Stock {
+	response := executeWebhookRequest(r, method, url, body)
+	require.Equal(t, status, response.Code, response.Body.String())
+	var stock entities.Stock
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&stock))
+	return stock
+}
+
+func reserve(t *testing.T, r http.Handler, body string) entities.Reservation {
+	response := executeWebhookRequest(r, "POST", "/items/item1/reservations", body)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	var reservation entities.Reservation
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&reservation))
+	assert.Equal(t, "/reservations/"+reservation.ID.String(), response.Header().Get("Location"))
+	return reservation
+}
+
+func TestStockController_ShouldReceiveAndShip(t *testing.T) {
+	r, _ := setupStock(t)
+
+	stock := decodeStock(t, r, "GET", "/items/item1/stock", "", http.StatusOK)
+	assert.Equal(t, 0, stock.OnHand)
+
+	stock = decodeStock(t, r, "POST", "/items/item1/stock/receive", `{"quantity":10,"reason":"PO-1"}`, http.StatusOK)
+	assert.Equal(t, 10, stock.OnHand)
+	assert.Equal(t, 10, stock.Available)
+
+	stock = decodeStock(t, r, "POST", "/items/item1/stock/ship", `{"quantity":4}`, http.StatusOK)
+	assert.Equal(t, 6, stock.OnHand)
+
+	response := executeWebhookRequest(r, "POST", "/items/item1/stock/ship", `{"quantity":7}`)
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Equal(t, 6, decodeStock(t, r, "GET", "/items/item1/stock", "", http.StatusOK).OnHand)
+}
+
+func TestStockController_ShouldRejectInvalidAdjustments(t *testing.T) {
+	r, _ := setupStock(t)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, executeWebhookRequest(r, "POST", "/items/item1/stock/receive", `{"quantity":0}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, executeWebhookRequest(r, "POST", "/items/item1/stock/correct", `{"on_hand":5}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, executeWebhookRequest(r, "POST", "/items/item1/stock/correct", `{"on_hand":-1,"reason":"count"}`).Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "POST", "/items/item2/stock/receive", `{"quantity":1}`).Code)
+}
+
+func TestStockController_ShouldRecordMovementsNewestFirst(t *testing.T) {
+	r, _ := setupStock(t)
+	decodeStock(t, r, "POST", "/items/item1/stock/receive", `{"quantity":10}`, http.StatusOK)
+	decodeStock(t, r, "POST", "/items/item1/stock/correct", `{"on_hand":8,"reason":"cycle count"}`, http.StatusOK)
+
+	response := executeWebhookRequest(r, "GET", "/items/item1/stock/movements", "")
+	require.Equal(t, http.StatusOK, response.Code)
+	var movements []entities.StockMovement
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&movements))
+	require.Len(t, movements, 2)
+	assert.Equal(t, entities.MovementCorrect, movements[0].Kind)
+	assert.Equal(t, -2, movements[0].Quantity)
+	assert.Equal(t, 8, movements[0].OnHandAfter)
+	assert.Equal(t, "cycle count", movements[0].Reason)
+	assert.Equal(t, entities.MovementReceive, movements[1].Kind)
+
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/items/item1/stock/movements?limit=0", "").Code)
+}
+
+func TestStockController_ReservationsShouldHoldStockUntilTheyExpire(t *testing.T) {
+	r, now := setupStock(t)
+	decodeStock(t, r, "POST", "/items/item1/stock/receive", `{"quantity":5}`, http.StatusOK)
+
+	reservation := reserve(t, r, `{"quantity":3,"ttl_seconds":60}`)
+	assert.Equal(t, now.Add(time.Minute), reservation.ExpiresAt)
+
+	stock := decodeStock(t, r, "GET", "/items/item1/stock", "", http.StatusOK)
+	assert.Equal(t, 3, stock.Reserved)
+	assert.Equal(t, 2, stock.Available)
+	assert.Equal(t, http.StatusConflict, executeWebhookRequest(r, "POST", "/items/item1/reservations", `{"quantity":3}`).Code)
+	assert.Equal(t, http.StatusConflict, executeWebhookRequest(r, "POST", "/items/item1/stock/ship", `{"quantity":3}`).Code)
+	assert.Equal(t, http.StatusConflict, executeWebhookRequest(r, "POST", "/items/item1/stock/correct", `{"on_hand":2,"reason":"count"}`).Code)
+
+	*now = now.Add(2 * time.Minute)
+
+	assert.Equal(t, 5, decodeStock(t, r, "GET", "/items/item1/stock", "", http.StatusOK).Available)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "GET", "/reservations/"+reservation.ID.String(), "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "POST", "/reservations/"+reservation.ID.String()+"/commit", "").Code)
+}
+
+func TestStockController_CommitShouldShipReservedUnits(t *testing.T) {
+	r, _ := setupStock(t)
+	decodeStock(t, r, "POST", "/items/item1/stock/receive", `{"quantity":5}`, http.StatusOK)
+	reservation := reserve(t, r, `{"quantity":2}`)
+
+	stock := decodeStock(t, r, "POST", "/reservations/"+reservation.ID.String()+"/commit", "", http.StatusOK)
+	assert.Equal(t, 3, stock.OnHand)
+	assert.Equal(t, 0, stock.Reserved)
+
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "POST", "/reservations/"+reservation.ID.String()+"/commit", "").Code)
+}
+
+func TestStockController_ReleaseShouldFreeReservedUnits(t *testing.T) {
+	r, _ := setupStock(t)
+	decodeStock(t, r, "POST", "/items/item1/stock/receive", `{"quantity":5}`, http.StatusOK)
+	reservation := reserve(t, r, `{"quantity":5}`)
+
+	assert.Equal(t, http.StatusOK, executeWebhookRequest(r, "GET", "/reservations/"+reservation.ID.String(), "").Code)
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/reservations/"+reservation.ID.String(), "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "DELETE", "/reservations/"+reservation.ID.String(), "").Code)
+	assert.Equal(t, 5, decodeStock(t, r, "GET", "/items/item1/stock", "", http.StatusOK).Available)
+
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/reservations/not-a-uuid", "").Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, executeWebhookRequest(r, "POST", "/items/item1/reservations", `{"quantity":1,"ttl_seconds":90000}`).Code)
+}
+
+func TestStockController_ShouldOnlyReserveActiveItems(t *testing.T) {
+	itemRepo := mocks.NewMockItemRepository()
+	items := usecases.NewItemUseCase(itemRepo)
+	_, err := items.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1", Status: entities.StatusDraft})
+	require.NoError(t, err)
+	ctrl := controllers.NewStockController(usecases.NewStockUseCase(mocks.NewMockStockRepository(), itemRepo))
+
+	r := chi.NewRouter()
+	r.Post("/items/{name}/stock/receive", ctrl.Receive)
+	r.Post("/items/{name}/reservations", ctrl.Reserve)
+	decodeStock(t, r, "POST", "/items/item1/stock/receive", `{"quantity":5}`, http.StatusOK)
+
+	assert.Equal(t, http.StatusConflict, executeWebhookRequest(r, "POST", "/items/item1/reservations", `{"quantity":1}`).Code)
+	_, err = items.TransitionItem("item1", entities.ActionPublish)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, executeWebhookRequest(r, "POST", "/items/item1/reservations", `{"quantity":1}`).Code)
+	_, err = items.TransitionItem("item1", entities.ActionDiscontinue)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, executeWebhookRequest(r, "POST", "/items/item1/reservations", `{"quantity":1}`).Code)
+}
@@ -0,0 +1,154 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+func setupWebhooks() (*chi.Mux, *mocks.MockWebhookRepository) {
+	repo := mocks.NewMockWebhookRepository()
+	ctrl := controllers.NewWebhookController(usecases.NewWebhookUseCase(repo))
+	r := chi.NewRouter()
+	r.Get("/webhooks", ctrl.ListWebhooks)
+	r.Post("/webhooks", ctrl.CreateWebhook)
+	r.Get("/webhooks/dead-letters", ctrl.ListDeadLetters)
+	r.Post("/webhooks/dead-letters/{id}/retry", ctrl.RetryDelivery)
+	r.Get("/webhooks/{id}", ctrl.GetWebhook)
+	r.Put("/webhooks/{id}", ctrl.UpdateWebhook)
+	r.Delete("/webhooks/{id}", ctrl.DeleteWebhook)
+	r.Get("/webhooks/{id}/deliveries", ctrl.ListDeliveries)
+	return r, repo
+}
+
+func executeWebhookRequest(r http.Handler, method string, url string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func createWebhook(t *testing.T, r http.Handler, body string) webhook.Webhook {
+	response := executeWebhookRequest(r, "POST", "/webhooks", body)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	var hook webhook.Webhook
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&hook))
+	return hook
+}
+
+func TestWebhookController_CreateShouldReturnSecretOnce(t *testing.T) {
+	r, _ := setupWebhooks()
+
+	response := executeWebhookRequest(r, "POST", "/webhooks", `{"url":"https://partner.example.com/hooks","events":["item.updated","item.created"]}`)
+
+	assert.Equal(t, http.StatusCreated, response.Code)
+	var created webhook.Webhook
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&created))
+	assert.Equal(t, "/webhooks/"+created.ID.String(), response.Header().Get("Location"))
+	assert.Equal(t, []string{"item.created", "item.updated"}, created.Events)
+	assert.True(t, created.Active)
+	assert.Len(t, created.Secret, 64)
+
+	response = executeWebhookRequest(r, "GET", "/webhooks/"+created.ID.String(), "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NotContains(t, response.Body.String(), "secret")
+
+	response = executeWebhookRequest(r, "GET", "/webhooks", "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NotContains(t, response.Body.String(), created.Secret)
+}
+
+func TestWebhookController_CreateShouldValidateInput(t *testing.T) {
+	r, _ := setupWebhooks()
+
+	for _, body := range []string{
+		`{"url":"ftp://partner.example.com"}`,
+		`{"url":"/relative"}`,
+		`{"url":"https://partner.example.com","events":["item.archived"]}`,
+		`{"url":"https://partner.example.com","secret":"short"}`,
+	} {
+		response := executeWebhookRequest(r, "POST", "/webhooks", body)
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code, body)
+	}
+}
+
+func TestWebhookController_UpdateShouldKeepSecret(t *testing.T) {
+	r, repo := setupWebhooks()
+	created := createWebhook(t, r, `{"url":"https://partner.example.com/hooks","secret":"0123456789abcdef"}`)
+
+	response := executeWebhookRequest(r, "PUT", "/webhooks/"+created.ID.String(), `{"url":"https://partner.example.com/v2","active":false}`)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	stored, _ := repo.GetWebhook(created.ID)
+	assert.Equal(t, "https://partner.example.com/v2", stored.URL)
+	assert.False(t, stored.Active)
+	assert.Equal(t, "0123456789abcdef", stored.Secret)
+}
+
+func TestWebhookController_ShouldReportMissingWebhooks(t *testing.T) {
+	r, _ := setupWebhooks()
+	missing := "/webhooks/" + uuid.New().String()
+
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "GET", missing, "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "PUT", missing, `{"url":"https://partner.example.com"}`).Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "DELETE", missing, "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "GET", missing+"/deliveries", "").Code)
+	assert.Equal(t, http.StatusBadRequest, executeWebhookRequest(r, "GET", "/webhooks/not-a-uuid", "").Code)
+}
+
+func TestWebhookController_DeleteShouldRemoveWebhook(t *testing.T) {
+	r, _ := setupWebhooks()
+	created := createWebhook(t, r, `{"url":"https://partner.example.com/hooks"}`)
+
+	assert.Equal(t, http.StatusNoContent, executeWebhookRequest(r, "DELETE", "/webhooks/"+created.ID.String(), "").Code)
+	assert.Equal(t, http.StatusNotFound, executeWebhookRequest(r, "GET", "/webhooks/"+created.ID.String(), "").Code)
+}
+
+func TestWebhookController_DeliveryLogAndDeadLetterRetry(t *testing.T) {
+	r, repo := setupWebhooks()
+	created := createWebhook(t, r, `{"url":"https://partner.example.com/hooks"}`)
+	now := time.Now().UTC()
+	repo.EnqueueDeliveries(1, "item.created", []byte(`{"event_id":1}`), now)
+	repo.EnqueueDeliveries(2, "item.deleted", []byte(`{"event_id":2}`), now)
+	deliveries, _ := repo.ListDeliveries(created.ID, 10)
+	repo.MarkDead(deliveries[0].ID, http.StatusGone, "unexpected response status 410", now)
+
+	response := executeWebhookRequest(r, "GET", "/webhooks/"+created.ID.String()+"/deliveries?limit=1", "")
+	assert.Equal(t, http.StatusOK, response.Code)
+	var log []webhook.Delivery
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&log))
+	assert.Len(t, log, 1)
+	assert.Equal(t, int64(2), log[0].EventID)
+
+	response = executeWebhookRequest(r, "GET", "/webhooks/dead-letters", "")
+	var dead []webhook.Delivery
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&dead))
+	assert.Len(t, dead, 1)
+	assert.Equal(t, "unexpected response status 410", dead[0].LastError)
+
+	response = executeWebhookRequest(r, "POST", "/webhooks/dead-letters/"+dead[0].ID.String()+"/retry", "")
+	assert.Equal(t, http.StatusAccepted, response.Code)
+	var requeued webhook.Delivery
+	assert.NoError(t, json.NewDecoder(response.Body).Decode(&requeued))
+	assert.Equal(t, webhook.DeliveryPending, requeued.Status)
+	assert.Equal(t, 0, requeued.Attempts)
+
+	response = executeWebhookRequest(r, "POST", "/webhooks/dead-letters/"+dead[0].ID.String()+"/retry", "")
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
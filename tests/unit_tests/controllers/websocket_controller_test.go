@@ -0,0 +1,158 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wsMessage struct {
+	Type  string      `json:"type"`
+	Names []string    `json:"names"`
+	IDs   []uuid.UUID `json:"ids"`
+	Error string      `json:"error"`
+	Event *struct {
+		ID   uint64         `json:"id"`
+		Type string         `json:"type"`
+		Item *entities.Item `json:"item"`
+	} `json:"event"`
+}
+
+func setupWebSocket(t *testing.T) (*controllers.WebSocketController, *usecases.ItemUseCase_Impl, *httptest.Server) {
+	useCase := usecases.NewItemUseCase(mocks.NewMockItemRepository())
+	ctrl := controllers.NewWebSocketController(useCase.Events)
+	server := httptest.NewServer(http.HandlerFunc(ctrl.Subscribe))
+	t.Cleanup(server.Close)
+	return ctrl, useCase, server
+}
+
+func dialWebSocket(t *testing.T, server *httptest.Server) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) wsMessage {
+	var msg wsMessage
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+func TestWebSocketController_ShouldDeliverOnlySubscribedItems(t *testing.T) {
+	_, useCase, server := setupWebSocket(t)
+	conn := dialWebSocket(t, server)
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"action": "subscribe", "names": []string{"item2"}}))
+	ack := readMessage(t, conn)
+	assert.Equal(t, "subscribed", ack.Type)
+	assert.Equal(t, []string{"item2"}, ack.Names)
+
+	useCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	useCase.CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2"})
+
+	msg := readMessage(t, conn)
+	assert.Equal(t, "event", msg.Type)
+	assert.Equal(t, "item.created", msg.Event.Type)
+	assert.Equal(t, "item2", msg.Event.Item.Name)
+}
+
+func TestWebSocketController_ShouldFilterByID(t *testing.T) {
+	_, useCase, server := setupWebSocket(t)
+	conn := dialWebSocket(t, server)
+	created, _ := useCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"action": "subscribe", "ids": []uuid.UUID{created.ID}}))
+	assert.Equal(t, "subscribed", readMessage(t, conn).Type)
+
+	useCase.UpdateItem("item1", &entities.Item{Name: "renamed", Price: 15.0, Description: "Description1"})
+
+	msg := readMessage(t, conn)
+	assert.Equal(t, "item.updated", msg.Event.Type)
+	assert.Equal(t, "renamed", msg.Event.Item.Name)
+}
+
+func TestWebSocketController_ShouldStopDeliveringAfterUnsubscribe(t *testing.T) {
+	_, useCase, server := setupWebSocket(t)
+	conn := dialWebSocket(t, server)
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"action": "subscribe", "names": []string{"item1", "item2"}}))
+	readMessage(t, conn)
+	require.NoError(t, conn.WriteJSON(map[string]any{"action": "unsubscribe", "names": []string{"item1"}}))
+	ack := readMessage(t, conn)
+	assert.Equal(t, "unsubscribed", ack.Type)
+	assert.Equal(t, []string{"item2"}, ack.Names)
+
+	useCase.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	useCase.CreateItem(&entities.Item{Name: "item2", Price: 20.0, Description: "Description2"})
+
+	assert.Equal(t, "item2", readMessage(t, conn).Event.Item.Name)
+}
+
+func TestWebSocketController_ShouldReportInvalidMessages(t *testing.T) {
+	_, _, server := setupWebSocket(t)
+	conn := dialWebSocket(t, server)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("not json")))
+	assert.Equal(t, "error", readMessage(t, conn).Type)
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"action": "dance"}))
+	msg := readMessage(t, conn)
+	assert.Equal(t, "error", msg.Type)
+	assert.Contains(t, msg.Error, "unknown action")
+}
+
+func TestWebSocketController_ShouldLimitSubscriptions(t *testing.T) {
+	ctrl, _, server := setupWebSocket(t)
+	ctrl.MaxSubscriptions = 1
+	conn := dialWebSocket(t, server)
+
+	require.NoError(t, conn.WriteJSON(map[string]any{"action": "subscribe", "names": []string{"item1", "item2"}}))
+
+	assert.Equal(t, "error", readMessage(t, conn).Type)
+}
+
+func TestWebSocketController_ShouldRejectConnectionsOverLimit(t *testing.T) {
+	ctrl, _, server := setupWebSocket(t)
+	ctrl.MaxConnections = 1
+	dialWebSocket(t, server)
+
+	_, response, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Equal(t, int64(1), ctrl.ActiveConnections())
+}
+
+func TestWebSocketController_ShouldDisconnectSlowConsumers(t *testing.T) {
+	ctrl, useCase, server := setupWebSocket(t)
+	ctrl.SendBuffer = 1
+	conn := dialWebSocket(t, server)
+	require.NoError(t, conn.WriteJSON(map[string]any{"action": "subscribe", "names": []string{"item1"}}))
+	readMessage(t, conn)
+
+	for i := 0; i < 1000; i++ {
+		useCase.Events.Publish(events.ItemUpdated, &entities.Item{Name: "item1"})
+	}
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var err error
+	for err == nil {
+		_, _, err = conn.ReadMessage()
+	}
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseTryAgainLater))
+}
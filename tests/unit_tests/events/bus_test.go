@@ -0,0 +1,72 @@
+package events_test
+
+import (
+	"testing"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus_ShouldDeliverToSubscribers(t *testing.T) {
+	bus := events.NewBus()
+	ch, unsubscribe := bus.Subscribe(1)
+	defer unsubscribe()
+
+	published := bus.Publish(events.ItemCreated, &entities.Item{Name: "item1"})
+
+	received := <-ch
+	assert.Equal(t, published.ID, received.ID)
+	assert.Equal(t, events.ItemCreated, received.Type)
+	assert.Equal(t, "item1", received.Item.Name)
+}
+
+func TestBus_ShouldDisconnectSlowSubscribers(t *testing.T) {
+	bus := events.NewBus()
+	ch, unsubscribe := bus.Subscribe(1)
+	defer unsubscribe()
+
+	bus.Publish(events.ItemCreated, &entities.Item{Name: "item1"})
+	bus.Publish(events.ItemCreated, &entities.Item{Name: "item2"})
+
+	<-ch
+	_, open := <-ch
+	assert.False(t, open)
+}
+
+func TestBus_SubscribeFromShouldReturnMissedEvents(t *testing.T) {
+	bus := events.NewBusWithReplay(3)
+	for _, name := range []string{"a", "b", "c"} {
+		bus.Publish(events.ItemCreated, &entities.Item{Name: name})
+	}
+
+	missed, complete, _, unsubscribe := bus.SubscribeFrom(1, 1)
+	defer unsubscribe()
+
+	assert.True(t, complete)
+	assert.Len(t, missed, 2)
+	assert.Equal(t, uint64(2), missed[0].ID)
+	assert.Equal(t, uint64(3), missed[1].ID)
+}
+
+func TestBus_SubscribeFromShouldReportGaps(t *testing.T) {
+	bus := events.NewBusWithReplay(2)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		bus.Publish(events.ItemCreated, &entities.Item{Name: name})
+	}
+
+	missed, complete, _, unsubscribe := bus.SubscribeFrom(1, 1)
+	defer unsubscribe()
+
+	assert.False(t, complete)
+	assert.Len(t, missed, 2)
+
+	missed, complete, _, unsubscribe = bus.SubscribeFrom(4, 1)
+	defer unsubscribe()
+	assert.True(t, complete)
+	assert.Empty(t, missed)
+
+	_, complete, _, unsubscribe = bus.SubscribeFrom(10, 1)
+	defer unsubscribe()
+	assert.False(t, complete)
+}
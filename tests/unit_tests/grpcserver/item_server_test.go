@@ -0,0 +1,180 @@
+package grpcserver_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/grpcserver"
+	"github.com/afornagieri/go_api_template/internal/adapter/grpcserver/itemsv1"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func setupClient(t *testing.T) itemsv1.ItemServiceClient {
+	client, _ := setupClientWithRepo(t)
+	return client
+}
+
+func setupClientWithRepo(t *testing.T) (itemsv1.ItemServiceClient, *mocks.MockItemRepository) {
+	repo := mocks.NewMockItemRepository()
+	useCase := usecases.NewItemUseCase(repo)
+	server := grpcserver.NewServer(grpcserver.NewItemServer(useCase, useCase.Events))
+
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return itemsv1.NewItemServiceClient(conn), repo
+}
+
+func TestItemServer_CreateAndGet(t *testing.T) {
+	client := setupClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "item1", Price: 10, Description: "Description1"}})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.GetId())
+
+	item, err := client.GetItem(ctx, &itemsv1.GetItemRequest{Name: "item1"})
+	assert.NoError(t, err)
+	assert.Equal(t, created.GetId(), item.GetId())
+}
+
+func TestItemServer_ShouldMapDomainErrors(t *testing.T) {
+	client := setupClient(t)
+	ctx := context.Background()
+
+	_, err := client.GetItem(ctx, &itemsv1.GetItemRequest{Name: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "item1", Price: 10, Description: "Description1"}})
+	_, err = client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "item1", Price: 10, Description: "Description1"}})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = client.DeleteItem(ctx, &itemsv1.DeleteItemRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.UpdateItem(ctx, &itemsv1.UpdateItemRequest{Name: "missing", Item: &itemsv1.Item{Name: "missing", Price: 10, Description: "Description1"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestItemServer_ShouldValidateItems(t *testing.T) {
+	client := setupClient(t)
+	ctx := context.Background()
+
+	_, err := client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "", Price: 10, Description: "Description1"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "item1", Price: 10, Description: "Description1"}})
+	_, err = client.UpdateItem(ctx, &itemsv1.UpdateItemRequest{Name: "item1", Item: &itemsv1.Item{Name: "", Price: 10, Description: "Description1"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.UpdateItem(ctx, &itemsv1.UpdateItemRequest{Name: "item1", Item: &itemsv1.Item{Name: "item1", Price: 0, Description: "Description1"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestItemServer_ShouldRoundTripCurrencyStatusAndAudit(t *testing.T) {
+	client := setupClient(t)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-actor", "alice")
+
+	created, err := client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "item1", Price: 10, Description: "Description1", Currency: "eur", Status: entities.StatusDraft}})
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", created.GetCurrency())
+	assert.Equal(t, entities.StatusDraft, created.GetStatus())
+	assert.Equal(t, "alice", created.GetCreatedBy())
+	assert.Equal(t, "alice", created.GetUpdatedBy())
+	createdAt, err := time.Parse(time.RFC3339Nano, created.GetCreatedAt())
+	assert.NoError(t, err)
+	assert.False(t, createdAt.IsZero())
+
+	_, err = client.UpdateItem(ctx, &itemsv1.UpdateItemRequest{Name: "item1", Item: &itemsv1.Item{Name: "item1", Price: 10, Description: "Description1", Status: entities.StatusActive}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestItemServer_ListItemsPaginates(t *testing.T) {
+	client := setupClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"a", "b", "c"} {
+		client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: name, Price: 1, Description: "d"}})
+	}
+
+	page, err := client.ListItems(ctx, &itemsv1.ListItemsRequest{PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, page.GetItems(), 2)
+	assert.NotEmpty(t, page.GetNextPageToken())
+
+	page, err = client.ListItems(ctx, &itemsv1.ListItemsRequest{PageSize: 2, PageToken: page.GetNextPageToken()})
+	assert.NoError(t, err)
+	assert.Len(t, page.GetItems(), 1)
+	assert.Equal(t, "c", page.GetItems()[0].GetName())
+	assert.Empty(t, page.GetNextPageToken())
+}
+
+func TestItemServer_ListItemsOnlyReturnsActiveItems(t *testing.T) {
+	client, repo := setupClientWithRepo(t)
+	ctx := context.Background()
+
+	repo.CreateItem(&entities.Item{Name: "a", Price: 1, Description: "d", Status: entities.StatusActive})
+	repo.CreateItem(&entities.Item{Name: "b", Price: 1, Description: "d", Status: entities.StatusDraft})
+	repo.CreateItem(&entities.Item{Name: "c", Price: 1, Description: "d", Status: entities.StatusArchived})
+
+	page, err := client.ListItems(ctx, &itemsv1.ListItemsRequest{PageSize: 10})
+	assert.NoError(t, err)
+	assert.Len(t, page.GetItems(), 1)
+	assert.Equal(t, "a", page.GetItems()[0].GetName())
+}
+
+func TestItemServer_WatchItemsStreamsChanges(t *testing.T) {
+	client := setupClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchItems(ctx, &itemsv1.WatchItemsRequest{Names: []string{"watched"}})
+	assert.NoError(t, err)
+	_, err = stream.Header()
+	assert.NoError(t, err)
+
+	client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "watched", Price: 1, Description: "d"}})
+	client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "ignored", Price: 1, Description: "d"}})
+	client.DeleteItem(ctx, &itemsv1.DeleteItemRequest{Name: "watched"})
+
+	first, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, itemsv1.ItemEvent_TYPE_CREATED, first.GetType())
+
+	second, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, itemsv1.ItemEvent_TYPE_DELETED, second.GetType())
+	assert.Equal(t, "watched", second.GetItem().GetName())
+}
+
+func TestItemServer_ShouldRejectRenameOntoExistingName(t *testing.T) {
+	client := setupClient(t)
+	ctx := context.Background()
+
+	client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "item1", Price: 10, Description: "Description1"}})
+	client.CreateItem(ctx, &itemsv1.CreateItemRequest{Item: &itemsv1.Item{Name: "item2", Price: 20, Description: "Description2"}})
+
+	_, err := client.UpdateItem(ctx, &itemsv1.UpdateItemRequest{Name: "item1", Item: &itemsv1.Item{Name: "item2", Price: 10, Description: "Description1"}})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
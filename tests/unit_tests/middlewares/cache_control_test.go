@@ -0,0 +1,77 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/httpcache"
+	"github.com/afornagieri/go_api_template/internal/adapter/middlewares"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupCachedRouter(status int) http.Handler {
+	cacheControl := middlewares.NewCacheControl(map[string]httpcache.Policy{
+		"GET /items":        {Public: true, MaxAge: time.Minute, SharedMaxAge: 5 * time.Minute},
+		"GET /items/{name}": {NoStore: true},
+	})
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	})
+
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		r.Use(cacheControl.Handler)
+		r.Get("/items", handler)
+		r.Post("/items", handler)
+		r.Get("/items/{name}", handler)
+		r.Get("/items/export", handler)
+	})
+	return r
+}
+
+func cacheControlFor(handler http.Handler, method string, path string) string {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+	return recorder.Header().Get("Cache-Control")
+}
+
+func TestCacheControl_ShouldApplyRoutePolicy(t *testing.T) {
+	handler := setupCachedRouter(http.StatusOK)
+
+	assert.Equal(t, "public, max-age=60, s-maxage=300", cacheControlFor(handler, "GET", "/items"))
+	assert.Equal(t, "no-store", cacheControlFor(handler, "GET", "/items/item1"))
+}
+
+func TestCacheControl_ShouldApplyPolicyToNotModified(t *testing.T) {
+	handler := setupCachedRouter(http.StatusNotModified)
+
+	assert.Equal(t, "public, max-age=60, s-maxage=300", cacheControlFor(handler, "GET", "/items"))
+}
+
+func TestCacheControl_ShouldApplyPolicyToPartialContent(t *testing.T) {
+	handler := setupCachedRouter(http.StatusPartialContent)
+
+	assert.Equal(t, "public, max-age=60, s-maxage=300", cacheControlFor(handler, "GET", "/items"))
+}
+
+func TestCacheControl_ShouldNotCacheErrors(t *testing.T) {
+	handler := setupCachedRouter(http.StatusInternalServerError)
+
+	assert.Equal(t, "no-store", cacheControlFor(handler, "GET", "/items"))
+}
+
+func TestCacheControl_ShouldIgnoreUnconfiguredRoutesAndMethods(t *testing.T) {
+	handler := setupCachedRouter(http.StatusOK)
+
+	assert.Empty(t, cacheControlFor(handler, "POST", "/items"))
+	assert.Empty(t, cacheControlFor(handler, "GET", "/items/export"))
+}
+
+func TestPolicy_String(t *testing.T) {
+	assert.Equal(t, "private, no-cache, max-age=0, must-revalidate", httpcache.Policy{Private: true, NoCache: true, MustRevalidate: true, SharedMaxAge: time.Minute}.String())
+	assert.Equal(t, "public, max-age=30, stale-while-revalidate=10", httpcache.Policy{Public: true, MaxAge: 30 * time.Second, StaleWhileRevalidate: 10 * time.Second}.String())
+}
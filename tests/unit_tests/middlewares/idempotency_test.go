@@ -0,0 +1,76 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/middlewares"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupIdempotentHandler(status int) (http.Handler, *int) {
+	calls := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(`{"call":` + strconv.Itoa(calls) + `}`))
+	})
+	idempotency := middlewares.NewIdempotency(mocks.NewMockIdempotencyRepository())
+	return idempotency.Handler(handler), &calls
+}
+
+func post(handler http.Handler, key string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/items", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(middlewares.IdempotencyKeyHeader, key)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestIdempotency_ShouldReplayStoredResponse(t *testing.T) {
+	handler, calls := setupIdempotentHandler(http.StatusCreated)
+
+	first := post(handler, "key-1", `{"name":"item"}`)
+	second := post(handler, "key-1", `{"name":"item"}`)
+
+	assert.Equal(t, 1, *calls)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "application/json", second.Header().Get("Content-Type"))
+	assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+}
+
+func TestIdempotency_ShouldRejectDifferentPayload(t *testing.T) {
+	handler, calls := setupIdempotentHandler(http.StatusCreated)
+
+	post(handler, "key-1", `{"name":"item"}`)
+	response := post(handler, "key-1", `{"name":"other"}`)
+
+	assert.Equal(t, 1, *calls)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+}
+
+func TestIdempotency_ShouldAllowRetryAfterServerError(t *testing.T) {
+	handler, calls := setupIdempotentHandler(http.StatusInternalServerError)
+
+	post(handler, "key-1", `{"name":"item"}`)
+	post(handler, "key-1", `{"name":"item"}`)
+
+	assert.Equal(t, 2, *calls)
+}
+
+func TestIdempotency_ShouldPassThroughWithoutKey(t *testing.T) {
+	handler, calls := setupIdempotentHandler(http.StatusCreated)
+
+	post(handler, "", `{"name":"item"}`)
+	post(handler, "", `{"name":"item"}`)
+
+	assert.Equal(t, 2, *calls)
+}
@@ -0,0 +1,177 @@
+package middlewares_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/afornagieri/go_api_template/internal/adapter/middlewares"
+	"github.com/afornagieri/go_api_template/internal/adapter/openapi"
+	"github.com/afornagieri/go_api_template/internal/adapter/representation"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupValidatedRouter() http.Handler {
+	representations := representation.NewDefaultRegistry()
+	doc := openapi.NewDocument(representations.MediaTypes())
+	validation := middlewares.NewRequestValidation(doc, representations)
+	validation.MaxBodySize = 128
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		r.Use(validation.Handler)
+		r.Post("/items", ok)
+		r.Post("/items/import", ok)
+		r.Put("/items/{name}", ok)
+	})
+	return r
+}
+
+func send(handler http.Handler, method string, path string, body string) (*httptest.ResponseRecorder, representation.ErrorResponse) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	var errResponse representation.ErrorResponse
+	json.Unmarshal(recorder.Body.Bytes(), &errResponse)
+	return recorder, errResponse
+}
+
+func TestRequestValidation_ShouldAcceptValidBody(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, _ := send(handler, "POST", "/items", `{"name":"item","price":10,"description":"Description"}`)
+
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
+
+func TestRequestValidation_ShouldRejectUnknownFields(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, errResponse := send(handler, "POST", "/items", `{"name":"item","price":10,"description":"Description","colour":"red"}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	assert.Len(t, errResponse.Details, 1)
+	assert.Equal(t, "/colour", errResponse.Details[0].Pointer)
+	assert.Equal(t, "is not a known field", errResponse.Details[0].Message)
+}
+
+func TestRequestValidation_ShouldPointAtInvalidFields(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, errResponse := send(handler, "PUT", "/items/item", `{"name":"","price":0}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	pointers := map[string]string{}
+	for _, detail := range errResponse.Details {
+		pointers[detail.Pointer] = detail.Message
+	}
+	assert.Equal(t, "is required", pointers["/description"])
+	assert.Equal(t, "must not be empty", pointers["/name"])
+	assert.Equal(t, "must be greater than 0", pointers["/price"])
+}
+
+func TestRequestValidation_ShouldRejectMalformedJSON(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, _ := send(handler, "POST", "/items", `{"name":`)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestRequestValidation_ShouldRejectOversizedBody(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, _ := send(handler, "POST", "/items", `{"name":"item","price":10,"description":"`+strings.Repeat("x", 200)+`"}`)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
+}
+
+func TestRequestValidation_ShouldRejectUnsupportedContentType(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	req := httptest.NewRequest("POST", "/items", strings.NewReader("name=item"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+}
+
+func sendAs(handler http.Handler, method string, path string, contentType string, body string) (*httptest.ResponseRecorder, representation.ErrorResponse) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	var errResponse representation.ErrorResponse
+	json.Unmarshal(recorder.Body.Bytes(), &errResponse)
+	return recorder, errResponse
+}
+
+func TestRequestValidation_ShouldValidateXMLBodies(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, _ := sendAs(handler, "POST", "/items", "application/xml", `<item><name>item</name><price>10</price><description>D</description></item>`)
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response, errResponse := sendAs(handler, "POST", "/items", "text/xml", `<item><name>item</name><price>0</price><description>D</description><colour>red</colour></item>`)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	pointers := map[string]string{}
+	for _, detail := range errResponse.Details {
+		pointers[detail.Pointer] = detail.Message
+	}
+	assert.Equal(t, "is not a known field", pointers["/colour"])
+	assert.Equal(t, "must be greater than 0", pointers["/price"])
+}
+
+func TestRequestValidation_ShouldValidateCSVBodies(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, _ := sendAs(handler, "POST", "/items", "text/csv", "name,price,description\nitem,10,D\n")
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response, errResponse := sendAs(handler, "POST", "/items", "text/csv", "name,price,description,colour\nitem,abc,D,red\n")
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	pointers := map[string]string{}
+	for _, detail := range errResponse.Details {
+		pointers[detail.Pointer] = detail.Message
+	}
+	assert.Equal(t, "is not a known field", pointers["/colour"])
+	assert.Equal(t, "must be a number", pointers["/price"])
+
+	response, _ = sendAs(handler, "POST", "/items", "text/csv", "name,price,description\nitem,10,D\nother,20,D\n")
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestRequestValidation_ShouldApplyBodyLimitToNonJSONBodies(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, _ := sendAs(handler, "POST", "/items", "text/csv", "name,price,description\nitem,10,"+strings.Repeat("x", 200)+"\n")
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
+}
+
+func TestRequestValidation_ShouldRejectMultipartOutsideUploads(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, _ := sendAs(handler, "POST", "/items", "multipart/form-data; boundary=x", "--x--\r\n")
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, response.Code)
+}
+
+func TestRequestValidation_ShouldApplyUploadLimitToImports(t *testing.T) {
+	handler := setupValidatedRouter()
+
+	response, _ := sendAs(handler, "POST", "/items/import", "text/csv", "name,price,description\nitem,10,"+strings.Repeat("x", 200)+"\nother,20,D\n")
+
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
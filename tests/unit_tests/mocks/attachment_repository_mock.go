@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type MockAttachmentRepository struct {
+	mu          sync.Mutex
+	attachments map[uuid.UUID][]*entities.Attachment
+}
+
+func NewMockAttachmentRepository() *MockAttachmentRepository {
+	return &MockAttachmentRepository{attachments: make(map[uuid.UUID][]*entities.Attachment)}
+}
+
+func (m *MockAttachmentRepository) ListAttachments(itemID uuid.UUID) ([]*entities.Attachment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	attachments := []*entities.Attachment{}
+	for _, attachment := range m.attachments[itemID] {
+		copied := *attachment
+		attachments = append(attachments, &copied)
+	}
+	return attachments, nil
+}
+
+func (m *MockAttachmentRepository) GetAttachment(itemID uuid.UUID, id uuid.UUID) (*entities.Attachment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, attachment := range m.attachments[itemID] {
+		if attachment.ID == id {
+			copied := *attachment
+			return &copied, nil
+		}
+	}
+	return nil, entities.ErrAttachmentNotFound
+}
+
+func (m *MockAttachmentRepository) AddAttachment(itemID uuid.UUID, attachment *entities.Attachment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	copied := *attachment
+	m.attachments[itemID] = append(m.attachments[itemID], &copied)
+	return nil
+}
+
+func (m *MockAttachmentRepository) DeleteAttachment(itemID uuid.UUID, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, attachment := range m.attachments[itemID] {
+		if attachment.ID == id {
+			m.attachments[itemID] = append(m.attachments[itemID][:i], m.attachments[itemID][i+1:]...)
+			return nil
+		}
+	}
+	return entities.ErrAttachmentNotFound
+}
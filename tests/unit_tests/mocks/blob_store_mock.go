@@ -0,0 +1,81 @@
+package mocks
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/afornagieri/go_api_template/internal/infra/blob"
+)
+
+type MockBlobStore struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+func NewMockBlobStore() *MockBlobStore {
+	return &MockBlobStore{blobs: make(map[string][]byte)}
+}
+
+func (m *MockBlobStore) Put(key string, r io.Reader) (int64, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blobs[key] = content
+	return int64(len(content)), nil
+}
+
+func (m *MockBlobStore) Open(key string) (io.ReadSeekCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	content, ok := m.blobs[key]
+	if !ok {
+		return nil, blob.ErrNotFound
+	}
+	return nopSeekCloser{bytes.NewReader(content)}, nil
+}
+
+func (m *MockBlobStore) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.blobs, key)
+	return nil
+}
+
+func (m *MockBlobStore) DeletePrefix(prefix string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key := range m.blobs {
+		if strings.HasPrefix(key, prefix+"/") {
+			delete(m.blobs, key)
+		}
+	}
+	return nil
+}
+
+func (m *MockBlobStore) Keys() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := make([]string, 0, len(m.blobs))
+	for key := range m.blobs {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,188 @@
+package mocks
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type MockCategoryRepository struct {
+	mu          sync.Mutex
+	items       repositories.ItemRepository
+	categories  map[uuid.UUID]*category.Category
+	assignments map[uuid.UUID]map[uuid.UUID]bool
+}
+
+func NewMockCategoryRepository(items repositories.ItemRepository) *MockCategoryRepository {
+	return &MockCategoryRepository{
+		items:       items,
+		categories:  make(map[uuid.UUID]*category.Category),
+		assignments: make(map[uuid.UUID]map[uuid.UUID]bool),
+	}
+}
+
+func (m *MockCategoryRepository) ListCategories() ([]*category.Category, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	categories := make([]*category.Category, 0, len(m.categories))
+	for _, c := range m.categories {
+		copied := *c
+		categories = append(categories, &copied)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i].Name < categories[j].Name })
+	return categories, nil
+}
+
+func (m *MockCategoryRepository) GetCategory(id uuid.UUID) (*category.Category, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, exists := m.categories[id]
+	if !exists {
+		return nil, category.ErrCategoryNotFound
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (m *MockCategoryRepository) CreateCategory(c *category.Category) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkPlacement(c); err != nil {
+		return err
+	}
+	copied := *c
+	m.categories[c.ID] = &copied
+	return nil
+}
+
+func (m *MockCategoryRepository) UpdateCategory(c *category.Category) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.categories[c.ID]; !exists {
+		return category.ErrCategoryNotFound
+	}
+	if err := m.checkPlacement(c); err != nil {
+		return err
+	}
+	for parent := c.ParentID; parent != nil; parent = m.categories[*parent].ParentID {
+		if *parent == c.ID {
+			return category.ErrCategoryCycle
+		}
+	}
+	copied := *c
+	m.categories[c.ID] = &copied
+	return nil
+}
+
+func (m *MockCategoryRepository) DeleteCategory(id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.categories[id]; !exists {
+		return category.ErrCategoryNotFound
+	}
+	if len(m.assignments[id]) > 0 {
+		return category.ErrCategoryNotEmpty
+	}
+	for _, c := range m.categories {
+		if c.ParentID != nil && *c.ParentID == id {
+			return category.ErrCategoryNotEmpty
+		}
+	}
+	delete(m.categories, id)
+	return nil
+}
+
+func (m *MockCategoryRepository) ListItems(id uuid.UUID, includeDescendants bool) ([]*entities.Item, error) {
+	m.mu.Lock()
+	if _, exists := m.categories[id]; !exists {
+		m.mu.Unlock()
+		return nil, category.ErrCategoryNotFound
+	}
+	tree := map[uuid.UUID]bool{id: true}
+	for includeDescendants {
+		grew := false
+		for _, c := range m.categories {
+			if c.ParentID != nil && tree[*c.ParentID] && !tree[c.ID] {
+				tree[c.ID] = true
+				grew = true
+			}
+		}
+		if !grew {
+			break
+		}
+	}
+	itemIDs := map[uuid.UUID]bool{}
+	for categoryID := range tree {
+		for itemID := range m.assignments[categoryID] {
+			itemIDs[itemID] = true
+		}
+	}
+	m.mu.Unlock()
+
+	items := []*entities.Item{}
+	for itemID := range itemIDs {
+		item, err := m.items.GetItemByID(itemID)
+		if err != nil {
+			continue
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+	return items, nil
+}
+
+func (m *MockCategoryRepository) AddItem(id uuid.UUID, itemID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.categories[id]; !exists {
+		return category.ErrCategoryNotFound
+	}
+	if m.assignments[id] == nil {
+		m.assignments[id] = make(map[uuid.UUID]bool)
+	}
+	m.assignments[id][itemID] = true
+	return nil
+}
+
+func (m *MockCategoryRepository) RemoveItem(id uuid.UUID, itemID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.assignments[id][itemID] {
+		return category.ErrAssignmentNotFound
+	}
+	delete(m.assignments[id], itemID)
+	return nil
+}
+
+func (m *MockCategoryRepository) checkPlacement(c *category.Category) error {
+	if c.ParentID != nil {
+		if _, exists := m.categories[*c.ParentID]; !exists {
+			return &category.ValidationError{Field: "parent_id", Message: "parent category does not exist"}
+		}
+	}
+	for _, other := range m.categories {
+		if other.ID != c.ID && other.Name == c.Name && sameParent(other.ParentID, c.ParentID) {
+			return category.ErrCategoryAlreadyExists
+		}
+	}
+	return nil
+}
+
+func sameParent(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
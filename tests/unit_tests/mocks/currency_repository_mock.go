@@ -0,0 +1,93 @@
+package mocks
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type MockCurrencyRepository struct {
+	rates  map[string]*entities.ExchangeRate
+	prices map[uuid.UUID]map[string]float64
+}
+
+func NewMockCurrencyRepository() *MockCurrencyRepository {
+	return &MockCurrencyRepository{
+		rates:  make(map[string]*entities.ExchangeRate),
+		prices: make(map[uuid.UUID]map[string]float64),
+	}
+}
+
+func (m *MockCurrencyRepository) ListRates() ([]*entities.ExchangeRate, error) {
+	rates := []*entities.ExchangeRate{}
+	for _, rate := range m.rates {
+		rates = append(rates, rate)
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i].Currency < rates[j].Currency })
+	return rates, nil
+}
+
+func (m *MockCurrencyRepository) GetRate(currency string) (*entities.ExchangeRate, error) {
+	rate, exists := m.rates[currency]
+	if !exists {
+		return nil, entities.ErrExchangeRateNotFound
+	}
+	return rate, nil
+}
+
+func (m *MockCurrencyRepository) SetRate(rate *entities.ExchangeRate) error {
+	m.rates[rate.Currency] = rate
+	return nil
+}
+
+func (m *MockCurrencyRepository) SetRates(rates []*entities.ExchangeRate) error {
+	for _, rate := range rates {
+		m.rates[rate.Currency] = rate
+	}
+	return nil
+}
+
+func (m *MockCurrencyRepository) DeleteRate(currency string) error {
+	if _, exists := m.rates[currency]; !exists {
+		return entities.ErrExchangeRateNotFound
+	}
+	delete(m.rates, currency)
+	return nil
+}
+
+func (m *MockCurrencyRepository) ListItemPrices(itemID uuid.UUID) ([]*entities.CurrencyPrice, error) {
+	prices := []*entities.CurrencyPrice{}
+	for currency, price := range m.prices[itemID] {
+		prices = append(prices, &entities.CurrencyPrice{Currency: currency, Price: price})
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Currency < prices[j].Currency })
+	return prices, nil
+}
+
+func (m *MockCurrencyRepository) PricesIn(currency string) (map[uuid.UUID]float64, error) {
+	prices := map[uuid.UUID]float64{}
+	for itemID, byCurrency := range m.prices {
+		if price, ok := byCurrency[currency]; ok {
+			prices[itemID] = price
+		}
+	}
+	return prices, nil
+}
+
+func (m *MockCurrencyRepository) SetItemPrice(itemID uuid.UUID, price *entities.CurrencyPrice) error {
+	if m.prices[itemID] == nil {
+		m.prices[itemID] = map[string]float64{}
+	}
+	m.prices[itemID][price.Currency] = price.Price
+	return nil
+}
+
+func (m *MockCurrencyRepository) DeleteItemPrice(itemID uuid.UUID, currency string) error {
+	if _, exists := m.prices[itemID][currency]; !exists {
+		return entities.ErrCurrencyPriceNotFound
+	}
+	delete(m.prices[itemID], currency)
+	return nil
+}
@@ -0,0 +1,55 @@
+package mocks
+
+import (
+	"sync"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type MockIdempotencyRepository struct {
+	mu      sync.Mutex
+	records map[string]*repositories.IdempotencyRecord
+}
+
+func NewMockIdempotencyRepository() *MockIdempotencyRepository {
+	return &MockIdempotencyRepository{
+		records: make(map[string]*repositories.IdempotencyRecord),
+	}
+}
+
+func (m *MockIdempotencyRepository) ReserveKey(key string, fingerprint string, expiredBefore time.Time) (*repositories.IdempotencyRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, exists := m.records[key]; exists {
+		if !rec.CreatedAt.Before(expiredBefore) {
+			copied := *rec
+			return &copied, nil
+		}
+	}
+	m.records[key] = &repositories.IdempotencyRecord{Key: key, Fingerprint: fingerprint, CreatedAt: time.Now().UTC()}
+	return nil, nil
+}
+
+func (m *MockIdempotencyRepository) SaveResponse(key string, statusCode int, headers map[string]string, body []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, exists := m.records[key]; exists {
+		rec.StatusCode = statusCode
+		rec.Headers = headers
+		rec.Body = append([]byte(nil), body...)
+	}
+	return nil
+}
+
+func (m *MockIdempotencyRepository) ReleaseKey(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, exists := m.records[key]; exists && !rec.Completed() {
+		delete(m.records, key)
+	}
+	return nil
+}
@@ -1,13 +1,24 @@
 package mocks
 
 import (
+	"encoding/json"
 	"errors"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
 )
 
 type MockItemRepository struct {
 	items                 map[string]*entities.Item
+	prices                []*entities.PriceChange
+	translations          map[uuid.UUID]map[string]*entities.Translation
+	variants              map[uuid.UUID]map[string]*entities.Variant
+	version               entities.CollectionVersion
 	shouldErrorGetItems   bool
 	shouldErrorGetItem    bool
 	shouldErrorCreateItem bool
@@ -17,7 +28,10 @@ type MockItemRepository struct {
 
 func NewMockItemRepository() *MockItemRepository {
 	return &MockItemRepository{
-		items: make(map[string]*entities.Item),
+		items:        make(map[string]*entities.Item),
+		translations: make(map[uuid.UUID]map[string]*entities.Translation),
+		variants:     make(map[uuid.UUID]map[string]*entities.Variant),
+		version:      entities.CollectionVersion{UpdatedAt: time.Now().UTC()},
 	}
 }
 
@@ -47,46 +61,503 @@ func (m *MockItemRepository) GetItems() ([]*entities.Item, error) {
 	return itemList, nil
 }
 
+func (m *MockItemRepository) ListItems(query entities.ItemQuery) ([]*entities.Item, error) {
+	if m.shouldErrorGetItems {
+		return nil, errors.New("internal server error")
+	}
+	var itemList []*entities.Item
+	for _, itm := range m.items {
+		if itm.Name <= query.AfterName {
+			continue
+		}
+		if query.NameContains != "" && !strings.Contains(strings.ToLower(itm.Name), strings.ToLower(query.NameContains)) {
+			continue
+		}
+		if query.MinPrice != nil && itm.Price < *query.MinPrice {
+			continue
+		}
+		if query.MaxPrice != nil && itm.Price > *query.MaxPrice {
+			continue
+		}
+		if query.CreatedAfter != nil && !itm.CreatedAt.After(*query.CreatedAfter) {
+			continue
+		}
+		if query.CreatedBefore != nil && !itm.CreatedAt.Before(*query.CreatedBefore) {
+			continue
+		}
+		if query.UpdatedAfter != nil && !itm.UpdatedAt.After(*query.UpdatedAfter) {
+			continue
+		}
+		if query.UpdatedBefore != nil && !itm.UpdatedAt.Before(*query.UpdatedBefore) {
+			continue
+		}
+		if query.CreatedBy != "" && itm.CreatedBy != query.CreatedBy {
+			continue
+		}
+		if query.UpdatedBy != "" && itm.UpdatedBy != query.UpdatedBy {
+			continue
+		}
+		if len(query.Tags) > 0 && !matchesTags(itm, query.Tags, query.MatchAnyTag) {
+			continue
+		}
+		if len(query.Statuses) > 0 && !slices.Contains(query.Statuses, itm.Status) {
+			continue
+		}
+		if query.Type != "" && itm.Type != query.Type {
+			continue
+		}
+		if len(query.Attributes) > 0 && !matchesAttributes(itm, query.Attributes) {
+			continue
+		}
+		itemList = append(itemList, itm)
+	}
+	sort.Slice(itemList, func(i, j int) bool {
+		a, b := itemList[i], itemList[j]
+		if query.Descending {
+			a, b = b, a
+		}
+		switch query.SortBy {
+		case entities.SortByPrice:
+			if a.Price != b.Price {
+				return a.Price < b.Price
+			}
+		case entities.SortByCreatedAt:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+		case entities.SortByUpdatedAt:
+			if !a.UpdatedAt.Equal(b.UpdatedAt) {
+				return a.UpdatedAt.Before(b.UpdatedAt)
+			}
+		default:
+			return a.Name < b.Name
+		}
+		return itemList[i].Name < itemList[j].Name
+	})
+	if query.Limit > 0 && len(itemList) > query.Limit {
+		itemList = itemList[:query.Limit]
+	}
+	return itemList, nil
+}
+
+func (m *MockItemRepository) StreamItems(statuses []string, fn func(item *entities.Item) error) error {
+	if m.shouldErrorGetItems {
+		return errors.New("internal server error")
+	}
+	for _, itm := range m.items {
+		if len(statuses) > 0 && !slices.Contains(statuses, itm.Status) {
+			continue
+		}
+		if err := fn(itm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *MockItemRepository) GetItemByName(name string) (*entities.Item, error) {
 	if m.shouldErrorGetItem {
 		return nil, errors.New("internal server error")
 	}
 	itm, exists := m.items[name]
 	if !exists {
-		return nil, errors.New("item not found")
+		return nil, entities.ErrItemNotFound
 	}
 	return itm, nil
 }
 
-func (m *MockItemRepository) CreateItem(itm *entities.Item) error {
+func (m *MockItemRepository) GetItemByID(id uuid.UUID) (*entities.Item, error) {
+	if m.shouldErrorGetItem {
+		return nil, errors.New("internal server error")
+	}
+	for _, itm := range m.items {
+		if itm.ID == id {
+			return itm, nil
+		}
+	}
+	return nil, entities.ErrItemNotFound
+}
+
+func (m *MockItemRepository) GetCollectionVersion() (*entities.CollectionVersion, error) {
+	if m.shouldErrorGetItems {
+		return nil, errors.New("internal server error")
+	}
+	version := m.version
+	return &version, nil
+}
+
+func (m *MockItemRepository) touch() {
+	m.version.Version++
+	m.version.UpdatedAt = time.Now().UTC()
+}
+
+func (m *MockItemRepository) CreateItem(itm *entities.Item) (*entities.Item, error) {
 	if m.shouldErrorCreateItem {
-		return errors.New("internal server error")
+		return nil, errors.New("internal server error")
 	}
 	if _, exists := m.items[itm.Name]; exists {
-		return errors.New("item already exists")
+		return nil, entities.ErrItemAlreadyExists
+	}
+	if itm.ID == uuid.Nil {
+		itm.ID = uuid.New()
+	}
+	if itm.Currency == "" {
+		itm.Currency = entities.BaseCurrency
 	}
+	if itm.Status == "" {
+		itm.Status = entities.StatusActive
+	}
+	m.touch()
 	m.items[itm.Name] = itm
-	return nil
+	m.prices = append(m.prices, entities.NewAppliedPriceChange(itm.ID, itm.Price, itm.CreatedBy, itm.CreatedAt))
+	return itm, nil
 }
 
-func (m *MockItemRepository) UpdateItem(name string, itm *entities.Item) error {
-	if m.shouldErrorUpdateItem {
+func (m *MockItemRepository) ImportItems(items []*entities.Item) error {
+	if m.shouldErrorCreateItem {
 		return errors.New("internal server error")
 	}
-	if _, exists := m.items[name]; !exists {
-		return errors.New("item not found")
+	for _, itm := range items {
+		if _, exists := m.items[itm.Name]; exists {
+			return entities.ErrItemAlreadyExists
+		}
+	}
+	for _, itm := range items {
+		m.touch()
+		m.items[itm.Name] = itm
+		m.prices = append(m.prices, entities.NewAppliedPriceChange(itm.ID, itm.Price, itm.CreatedBy, itm.CreatedAt))
 	}
-	m.items[name] = itm
 	return nil
 }
 
+func (m *MockItemRepository) UpdateItem(name string, itm *entities.Item) (*entities.Item, error) {
+	if m.shouldErrorUpdateItem {
+		return nil, errors.New("internal server error")
+	}
+	existing, exists := m.items[name]
+	if !exists {
+		return nil, entities.ErrItemNotFound
+	}
+	if _, taken := m.items[itm.Name]; taken && itm.Name != name {
+		return nil, entities.ErrItemAlreadyExists
+	}
+	itm.ID = existing.ID
+	itm.CreatedAt, itm.CreatedBy = existing.CreatedAt, existing.CreatedBy
+	itm.Tags = existing.Tags
+	itm.Status = existing.Status
+	if itm.Currency == "" {
+		itm.Currency = existing.Currency
+	}
+	if itm.Price != existing.Price || itm.Currency != existing.Currency {
+		m.prices = append(m.prices, entities.NewAppliedPriceChange(itm.ID, itm.Price, itm.UpdatedBy, itm.UpdatedAt))
+	}
+	m.touch()
+	delete(m.items, name)
+	m.items[itm.Name] = itm
+	return itm, nil
+}
+
 func (m *MockItemRepository) DeleteItem(name string) error {
 	if m.shouldErrorDeleteItem {
 		return errors.New("internal server error")
 	}
-	if _, exists := m.items[name]; !exists {
-		return errors.New("item not found")
+	existing, exists := m.items[name]
+	if !exists {
+		return entities.ErrItemNotFound
 	}
 	delete(m.items, name)
+	delete(m.translations, existing.ID)
+	delete(m.variants, existing.ID)
+	m.touch()
 	return nil
 }
+
+func (m *MockItemRepository) SetItemStatus(name string, from string, to string, actor string, at time.Time) (*entities.Item, error) {
+	if m.shouldErrorUpdateItem {
+		return nil, errors.New("internal server error")
+	}
+	itm, exists := m.items[name]
+	if !exists {
+		return nil, entities.ErrItemNotFound
+	}
+	if itm.Status != from {
+		return nil, entities.ErrInvalidTransition
+	}
+	itm.Status = to
+	itm.UpdatedAt, itm.UpdatedBy = at, actor
+	m.touch()
+	return itm, nil
+}
+
+func (m *MockItemRepository) AddTag(name string, tag string, actor string, at time.Time) (*entities.Item, bool, error) {
+	if m.shouldErrorUpdateItem {
+		return nil, false, errors.New("internal server error")
+	}
+	itm, exists := m.items[name]
+	if !exists {
+		return nil, false, entities.ErrItemNotFound
+	}
+	if itm.HasTag(tag) {
+		return itm, false, nil
+	}
+	itm.Tags = append(itm.Tags, tag)
+	sort.Strings(itm.Tags)
+	itm.UpdatedAt, itm.UpdatedBy = at, actor
+	m.touch()
+	return itm, true, nil
+}
+
+func (m *MockItemRepository) RemoveTag(name string, tag string, actor string, at time.Time) (*entities.Item, error) {
+	if m.shouldErrorUpdateItem {
+		return nil, errors.New("internal server error")
+	}
+	itm, exists := m.items[name]
+	if !exists {
+		return nil, entities.ErrItemNotFound
+	}
+	if !itm.HasTag(tag) {
+		return nil, entities.ErrTagNotFound
+	}
+	var tags []string
+	for _, t := range itm.Tags {
+		if t != tag {
+			tags = append(tags, t)
+		}
+	}
+	itm.Tags = tags
+	itm.UpdatedAt, itm.UpdatedBy = at, actor
+	m.touch()
+	return itm, nil
+}
+
+func (m *MockItemRepository) ListTags() ([]*entities.TagCount, error) {
+	if m.shouldErrorGetItems {
+		return nil, errors.New("internal server error")
+	}
+	counts := map[string]int{}
+	for _, itm := range m.items {
+		for _, tag := range itm.Tags {
+			counts[tag]++
+		}
+	}
+	tags := []*entities.TagCount{}
+	for tag, count := range counts {
+		tags = append(tags, &entities.TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Count != tags[j].Count {
+			return tags[i].Count > tags[j].Count
+		}
+		return tags[i].Tag < tags[j].Tag
+	})
+	return tags, nil
+}
+
+func (m *MockItemRepository) ListPriceChanges(itemID uuid.UUID) ([]*entities.PriceChange, error) {
+	changes := []*entities.PriceChange{}
+	for i := len(m.prices) - 1; i >= 0; i-- {
+		if m.prices[i].ItemID == itemID {
+			changes = append(changes, m.prices[i])
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].EffectiveFrom.After(changes[j].EffectiveFrom) })
+	return changes, nil
+}
+
+func (m *MockItemRepository) GetPriceChange(itemID uuid.UUID, id uuid.UUID) (*entities.PriceChange, error) {
+	for _, change := range m.prices {
+		if change.ID == id && change.ItemID == itemID {
+			return change, nil
+		}
+	}
+	return nil, entities.ErrPriceChangeNotFound
+}
+
+func (m *MockItemRepository) GetPriceAt(itemID uuid.UUID, at time.Time) (*entities.PriceChange, error) {
+	changes, _ := m.ListPriceChanges(itemID)
+	for _, change := range changes {
+		if !change.EffectiveFrom.After(at) {
+			return change, nil
+		}
+	}
+	return nil, entities.ErrPriceChangeNotFound
+}
+
+func (m *MockItemRepository) SchedulePriceChange(change *entities.PriceChange) error {
+	if _, err := m.GetItemByID(change.ItemID); err != nil {
+		return err
+	}
+	m.prices = append(m.prices, change)
+	return nil
+}
+
+func (m *MockItemRepository) CancelPriceChange(itemID uuid.UUID, id uuid.UUID) error {
+	for i, change := range m.prices {
+		if change.ID == id && change.ItemID == itemID && change.Pending() {
+			m.prices = append(m.prices[:i], m.prices[i+1:]...)
+			return nil
+		}
+	}
+	return entities.ErrPriceChangeNotFound
+}
+
+func (m *MockItemRepository) DuePriceChanges(now time.Time, limit int) ([]*entities.PriceChange, error) {
+	due := []*entities.PriceChange{}
+	for _, change := range m.prices {
+		if change.Pending() && !change.EffectiveFrom.After(now) && len(due) < limit {
+			due = append(due, change)
+		}
+	}
+	return due, nil
+}
+
+func (m *MockItemRepository) ApplyPriceChange(id uuid.UUID, at time.Time) (*entities.Item, error) {
+	for _, change := range m.prices {
+		if change.ID != id || !change.Pending() {
+			continue
+		}
+		itm, err := m.GetItemByID(change.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		change.AppliedAt = &at
+		itm.Price = change.Price
+		itm.UpdatedAt, itm.UpdatedBy = at, change.CreatedBy
+		m.touch()
+		return itm, nil
+	}
+	return nil, entities.ErrPriceChangeNotFound
+}
+
+func (m *MockItemRepository) ListTranslations(itemID uuid.UUID) ([]*entities.Translation, error) {
+	translations := []*entities.Translation{}
+	for _, t := range m.translations[itemID] {
+		translations = append(translations, t)
+	}
+	sort.Slice(translations, func(i, j int) bool { return translations[i].Locale < translations[j].Locale })
+	return translations, nil
+}
+
+func (m *MockItemRepository) GetTranslation(itemID uuid.UUID, locale string) (*entities.Translation, error) {
+	t, exists := m.translations[itemID][locale]
+	if !exists {
+		return nil, entities.ErrTranslationNotFound
+	}
+	return t, nil
+}
+
+func (m *MockItemRepository) TranslationsIn(itemIDs []uuid.UUID, locales []string) (map[uuid.UUID]map[string]*entities.Translation, error) {
+	translations := map[uuid.UUID]map[string]*entities.Translation{}
+	for _, itemID := range itemIDs {
+		byLocale := m.translations[itemID]
+		for _, locale := range locales {
+			if t, ok := byLocale[locale]; ok {
+				if translations[itemID] == nil {
+					translations[itemID] = map[string]*entities.Translation{}
+				}
+				translations[itemID][locale] = t
+			}
+		}
+	}
+	return translations, nil
+}
+
+func (m *MockItemRepository) SetTranslation(name string, t *entities.Translation) (*entities.Item, error) {
+	itm, exists := m.items[name]
+	if !exists {
+		return nil, entities.ErrItemNotFound
+	}
+	if m.translations[itm.ID] == nil {
+		m.translations[itm.ID] = map[string]*entities.Translation{}
+	}
+	m.translations[itm.ID][t.Locale] = t
+	itm.UpdatedAt, itm.UpdatedBy = t.UpdatedAt, t.UpdatedBy
+	m.touch()
+	return itm, nil
+}
+
+func (m *MockItemRepository) DeleteTranslation(name string, locale string, actor string, at time.Time) (*entities.Item, error) {
+	itm, exists := m.items[name]
+	if !exists {
+		return nil, entities.ErrItemNotFound
+	}
+	if _, exists := m.translations[itm.ID][locale]; !exists {
+		return nil, entities.ErrTranslationNotFound
+	}
+	delete(m.translations[itm.ID], locale)
+	itm.UpdatedAt, itm.UpdatedBy = at, actor
+	m.touch()
+	return itm, nil
+}
+
+func (m *MockItemRepository) GetItemWithVariants(name string) (*entities.Item, error) {
+	itm, exists := m.items[name]
+	if !exists {
+		return nil, entities.ErrItemNotFound
+	}
+	copied := *itm
+	copied.Variants = []*entities.Variant{}
+	for _, v := range m.variants[itm.ID] {
+		stored := *v
+		copied.Variants = append(copied.Variants, &stored)
+	}
+	sort.Slice(copied.Variants, func(i, j int) bool { return copied.Variants[i].SKU < copied.Variants[j].SKU })
+	copied.PriceVariants()
+	return &copied, nil
+}
+
+func (m *MockItemRepository) SaveVariant(name string, v *entities.Variant) (*entities.Item, error) {
+	itm, exists := m.items[name]
+	if !exists {
+		return nil, entities.ErrItemNotFound
+	}
+	for itemID, bySKU := range m.variants {
+		if _, taken := bySKU[v.SKU]; taken && itemID != itm.ID {
+			return nil, entities.ErrVariantAlreadyExists
+		}
+	}
+	if m.variants[itm.ID] == nil {
+		m.variants[itm.ID] = map[string]*entities.Variant{}
+	}
+	stored := *v
+	m.variants[itm.ID][v.SKU] = &stored
+	itm.UpdatedAt, itm.UpdatedBy = v.UpdatedAt, v.UpdatedBy
+	m.touch()
+	return itm, nil
+}
+
+func (m *MockItemRepository) DeleteVariant(name string, sku string, actor string, at time.Time) (*entities.Item, error) {
+	itm, exists := m.items[name]
+	if !exists {
+		return nil, entities.ErrItemNotFound
+	}
+	if _, exists := m.variants[itm.ID][sku]; !exists {
+		return nil, entities.ErrVariantNotFound
+	}
+	delete(m.variants[itm.ID], sku)
+	itm.UpdatedAt, itm.UpdatedBy = at, actor
+	m.touch()
+	return itm, nil
+}
+
+func matchesTags(itm *entities.Item, tags []string, matchAny bool) bool {
+	for _, tag := range tags {
+		if itm.HasTag(tag) == matchAny {
+			return matchAny
+		}
+	}
+	return !matchAny
+}
+
+func matchesAttributes(itm *entities.Item, filters []entities.AttributeFilter) bool {
+	var attributes map[string]any
+	if err := json.Unmarshal(itm.Attributes, &attributes); err != nil {
+		return false
+	}
+	for _, filter := range filters {
+		if !filter.Matches(attributes) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"sort"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type MockItemTypeRepository struct {
+	itemTypes map[string]*entities.ItemType
+	items     *MockItemRepository
+}
+
+func NewMockItemTypeRepository(items *MockItemRepository) *MockItemTypeRepository {
+	return &MockItemTypeRepository{itemTypes: make(map[string]*entities.ItemType), items: items}
+}
+
+func (m *MockItemTypeRepository) ListItemTypes() ([]*entities.ItemType, error) {
+	itemTypes := []*entities.ItemType{}
+	for _, itemType := range m.itemTypes {
+		itemTypes = append(itemTypes, itemType)
+	}
+	sort.Slice(itemTypes, func(i, j int) bool { return itemTypes[i].Name < itemTypes[j].Name })
+	return itemTypes, nil
+}
+
+func (m *MockItemTypeRepository) GetItemType(name string) (*entities.ItemType, error) {
+	itemType, exists := m.itemTypes[name]
+	if !exists {
+		return nil, entities.ErrItemTypeNotFound
+	}
+	return itemType, nil
+}
+
+func (m *MockItemTypeRepository) SaveItemType(itemType *entities.ItemType) error {
+	m.itemTypes[itemType.Name] = itemType
+	return nil
+}
+
+func (m *MockItemTypeRepository) DeleteItemType(name string) error {
+	if _, exists := m.itemTypes[name]; !exists {
+		return entities.ErrItemTypeNotFound
+	}
+	for _, itm := range m.items.items {
+		if itm.Type == name {
+			return entities.ErrItemTypeInUse
+		}
+	}
+	delete(m.itemTypes, name)
+	return nil
+}
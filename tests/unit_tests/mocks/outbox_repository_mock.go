@@ -0,0 +1,124 @@
+package mocks
+
+import (
+	"sync"
+	"time"
+
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type MockOutboxEvent struct {
+	repositories.OutboxRecord
+	NextAttemptAt time.Time
+	DeliveredAt   *time.Time
+	LastError     string
+	Purged        bool
+}
+
+type MockOutboxRepository struct {
+	mu           sync.Mutex
+	events       []*MockOutboxEvent
+	publications map[int64][]string
+}
+
+func NewMockOutboxRepository() *MockOutboxRepository {
+	return &MockOutboxRepository{publications: make(map[int64][]string)}
+}
+
+func (m *MockOutboxRepository) Add(eventType string, aggregateID string, payload []byte) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := int64(len(m.events) + 1)
+	m.events = append(m.events, &MockOutboxEvent{
+		OutboxRecord: repositories.OutboxRecord{
+			ID:          id,
+			EventType:   eventType,
+			AggregateID: aggregateID,
+			Payload:     payload,
+			CreatedAt:   time.Now().UTC(),
+		},
+	})
+	return id
+}
+
+func (m *MockOutboxRepository) Event(id int64) MockOutboxEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return *m.events[id-1]
+}
+
+func (m *MockOutboxRepository) PendingEvents(now time.Time, limit int) ([]*repositories.OutboxRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var records []*repositories.OutboxRecord
+	waiting := make(map[string]bool)
+	for _, evt := range m.events {
+		if evt.DeliveredAt != nil || evt.Purged {
+			continue
+		}
+		if !waiting[evt.AggregateID] && !evt.NextAttemptAt.After(now) && len(records) < limit {
+			record := evt.OutboxRecord
+			records = append(records, &record)
+		}
+		waiting[evt.AggregateID] = true
+	}
+	return records, nil
+}
+
+func (m *MockOutboxRepository) MarkDelivered(id int64, deliveredAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	evt := m.events[id-1]
+	evt.Attempts++
+	evt.DeliveredAt = &deliveredAt
+	evt.LastError = ""
+	delete(m.publications, id)
+	return nil
+}
+
+func (m *MockOutboxRepository) MarkFailed(id int64, nextAttemptAt time.Time, lastError string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	evt := m.events[id-1]
+	evt.Attempts++
+	evt.NextAttemptAt = nextAttemptAt
+	evt.LastError = lastError
+	return nil
+}
+
+func (m *MockOutboxRepository) PurgeDelivered(before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var purged int64
+	for _, evt := range m.events {
+		if !evt.Purged && evt.DeliveredAt != nil && evt.DeliveredAt.Before(before) {
+			evt.Purged = true
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func (m *MockOutboxRepository) PublishedTargets(eventID int64) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.publications[eventID]...), nil
+}
+
+func (m *MockOutboxRepository) MarkPublished(eventID int64, target string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, published := range m.publications[eventID] {
+		if published == target {
+			return nil
+		}
+	}
+	m.publications[eventID] = append(m.publications[eventID], target)
+	return nil
+}
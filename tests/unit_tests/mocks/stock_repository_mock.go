@@ -0,0 +1,142 @@
+package mocks
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+)
+
+type MockStockRepository struct {
+	mu           sync.Mutex
+	onHand       map[uuid.UUID]int
+	movements    map[uuid.UUID][]*entities.StockMovement
+	reservations map[uuid.UUID]*entities.Reservation
+}
+
+func NewMockStockRepository() *MockStockRepository {
+	return &MockStockRepository{
+		onHand:       make(map[uuid.UUID]int),
+		movements:    make(map[uuid.UUID][]*entities.StockMovement),
+		reservations: make(map[uuid.UUID]*entities.Reservation),
+	}
+}
+
+func (m *MockStockRepository) GetStock(itemID uuid.UUID, now time.Time) (*entities.Stock, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.stock(itemID, now), nil
+}
+
+func (m *MockStockRepository) AdjustStock(itemID uuid.UUID, kind entities.MovementKind, delta int, reason string, actor string, at time.Time) (*entities.Stock, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.adjust(itemID, kind, delta, reason, actor, nil, at)
+}
+
+func (m *MockStockRepository) CorrectStock(itemID uuid.UUID, onHand int, reason string, actor string, at time.Time) (*entities.Stock, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current := m.stock(itemID, at)
+	if onHand < current.Reserved {
+		return nil, entities.ErrInsufficientStock
+	}
+	return m.adjust(itemID, entities.MovementCorrect, onHand-current.OnHand, reason, actor, nil, at)
+}
+
+func (m *MockStockRepository) ListMovements(itemID uuid.UUID, limit int) ([]*entities.StockMovement, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	recorded := m.movements[itemID]
+	movements := []*entities.StockMovement{}
+	for i := len(recorded) - 1; i >= 0 && len(movements) < limit; i-- {
+		copied := *recorded[i]
+		movements = append(movements, &copied)
+	}
+	return movements, nil
+}
+
+func (m *MockStockRepository) Reserve(reservation *entities.Reservation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stock(reservation.ItemID, reservation.CreatedAt).Available < reservation.Quantity {
+		return entities.ErrInsufficientStock
+	}
+	copied := *reservation
+	m.reservations[reservation.ID] = &copied
+	return nil
+}
+
+func (m *MockStockRepository) GetReservation(id uuid.UUID, now time.Time) (*entities.Reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reservation, exists := m.reservations[id]
+	if !exists || !reservation.ExpiresAt.After(now) {
+		return nil, entities.ErrReservationNotFound
+	}
+	copied := *reservation
+	return &copied, nil
+}
+
+func (m *MockStockRepository) ReleaseReservation(id uuid.UUID, now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reservation, exists := m.reservations[id]
+	if !exists || !reservation.ExpiresAt.After(now) {
+		return entities.ErrReservationNotFound
+	}
+	delete(m.reservations, id)
+	return nil
+}
+
+func (m *MockStockRepository) CommitReservation(id uuid.UUID, actor string, at time.Time) (*entities.Stock, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reservation, exists := m.reservations[id]
+	if !exists || !reservation.ExpiresAt.After(at) {
+		return nil, entities.ErrReservationNotFound
+	}
+	delete(m.reservations, id)
+	return m.adjust(reservation.ItemID, entities.MovementShip, -reservation.Quantity, "", actor, &reservation.ID, at)
+}
+
+func (m *MockStockRepository) stock(itemID uuid.UUID, now time.Time) *entities.Stock {
+	reserved := 0
+	for _, reservation := range m.reservations {
+		if reservation.ItemID == itemID && reservation.ExpiresAt.After(now) {
+			reserved += reservation.Quantity
+		}
+	}
+	onHand := m.onHand[itemID]
+	return &entities.Stock{ItemID: itemID, OnHand: onHand, Reserved: reserved, Available: onHand - reserved}
+}
+
+func (m *MockStockRepository) adjust(itemID uuid.UUID, kind entities.MovementKind, delta int, reason string, actor string, reservationID *uuid.UUID, at time.Time) (*entities.Stock, error) {
+	current := m.stock(itemID, at)
+	if current.OnHand+delta < current.Reserved {
+		return nil, entities.ErrInsufficientStock
+	}
+	m.onHand[itemID] = current.OnHand + delta
+	m.movements[itemID] = append(m.movements[itemID], &entities.StockMovement{
+		ID:            uuid.New(),
+		ItemID:        itemID,
+		Kind:          kind,
+		Quantity:      delta,
+		OnHandAfter:   current.OnHand + delta,
+		Reason:        reason,
+		ReservationID: reservationID,
+		CreatedAt:     at,
+		CreatedBy:     actor,
+	})
+	return m.stock(itemID, at), nil
+}
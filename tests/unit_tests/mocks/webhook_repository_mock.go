@@ -0,0 +1,248 @@
+package mocks
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+type MockWebhookRepository struct {
+	mu         sync.Mutex
+	hooks      map[uuid.UUID]*webhook.Webhook
+	deliveries []*webhook.Delivery
+}
+
+func NewMockWebhookRepository() *MockWebhookRepository {
+	return &MockWebhookRepository{hooks: make(map[uuid.UUID]*webhook.Webhook)}
+}
+
+func (m *MockWebhookRepository) Delivery(id uuid.UUID) *webhook.Delivery {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, delivery := range m.deliveries {
+		if delivery.ID == id {
+			copied := *delivery
+			return &copied
+		}
+	}
+	return nil
+}
+
+func (m *MockWebhookRepository) ListWebhooks() ([]*webhook.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hooks := make([]*webhook.Webhook, 0, len(m.hooks))
+	for _, hook := range m.hooks {
+		copied := *hook
+		hooks = append(hooks, &copied)
+	}
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].CreatedAt.Before(hooks[j].CreatedAt) })
+	return hooks, nil
+}
+
+func (m *MockWebhookRepository) GetWebhook(id uuid.UUID) (*webhook.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hook, exists := m.hooks[id]
+	if !exists {
+		return nil, webhook.ErrWebhookNotFound
+	}
+	copied := *hook
+	return &copied, nil
+}
+
+func (m *MockWebhookRepository) CreateWebhook(hook *webhook.Webhook) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	copied := *hook
+	m.hooks[hook.ID] = &copied
+	return nil
+}
+
+func (m *MockWebhookRepository) UpdateWebhook(hook *webhook.Webhook) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.hooks[hook.ID]; !exists {
+		return webhook.ErrWebhookNotFound
+	}
+	copied := *hook
+	m.hooks[hook.ID] = &copied
+	return nil
+}
+
+func (m *MockWebhookRepository) DeleteWebhook(id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.hooks[id]; !exists {
+		return webhook.ErrWebhookNotFound
+	}
+	delete(m.hooks, id)
+	kept := m.deliveries[:0]
+	for _, delivery := range m.deliveries {
+		if delivery.WebhookID != id {
+			kept = append(kept, delivery)
+		}
+	}
+	m.deliveries = kept
+	return nil
+}
+
+func (m *MockWebhookRepository) EnqueueDeliveries(eventID int64, eventType string, payload []byte, now time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	enqueued := 0
+	for _, hook := range m.hooks {
+		if !hook.Matches(eventType) || m.hasDelivery(hook.ID, eventID) {
+			continue
+		}
+		next := now
+		m.deliveries = append(m.deliveries, &webhook.Delivery{
+			ID:            uuid.New(),
+			WebhookID:     hook.ID,
+			EventID:       eventID,
+			EventType:     eventType,
+			Payload:       payload,
+			Status:        webhook.DeliveryPending,
+			NextAttemptAt: &next,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		enqueued++
+	}
+	return enqueued, nil
+}
+
+func (m *MockWebhookRepository) DueDeliveries(now time.Time, limit int) ([]*repositories.DueDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var due []*repositories.DueDelivery
+	for _, delivery := range m.deliveries {
+		if delivery.Status != webhook.DeliveryPending || delivery.NextAttemptAt.After(now) || len(due) == limit {
+			continue
+		}
+		hook := m.hooks[delivery.WebhookID]
+		copied := *delivery
+		due = append(due, &repositories.DueDelivery{Delivery: &copied, URL: hook.URL, Secret: hook.Secret})
+	}
+	return due, nil
+}
+
+func (m *MockWebhookRepository) MarkDelivered(id uuid.UUID, responseStatus int, at time.Time) error {
+	return m.update(id, func(delivery *webhook.Delivery) {
+		delivery.Status = webhook.DeliveryDelivered
+		delivery.Attempts++
+		delivery.ResponseStatus = responseStatus
+		delivery.LastError = ""
+		delivery.NextAttemptAt = nil
+		delivery.UpdatedAt = at
+	})
+}
+
+func (m *MockWebhookRepository) MarkFailed(id uuid.UUID, responseStatus int, lastError string, nextAttemptAt time.Time, at time.Time) error {
+	return m.update(id, func(delivery *webhook.Delivery) {
+		delivery.Attempts++
+		delivery.ResponseStatus = responseStatus
+		delivery.LastError = lastError
+		delivery.NextAttemptAt = &nextAttemptAt
+		delivery.UpdatedAt = at
+	})
+}
+
+func (m *MockWebhookRepository) MarkDead(id uuid.UUID, responseStatus int, lastError string, at time.Time) error {
+	return m.update(id, func(delivery *webhook.Delivery) {
+		delivery.Status = webhook.DeliveryDead
+		delivery.Attempts++
+		delivery.ResponseStatus = responseStatus
+		delivery.LastError = lastError
+		delivery.NextAttemptAt = nil
+		delivery.UpdatedAt = at
+	})
+}
+
+func (m *MockWebhookRepository) ListDeliveries(webhookID uuid.UUID, limit int) ([]*webhook.Delivery, error) {
+	return m.list(limit, func(delivery *webhook.Delivery) bool { return delivery.WebhookID == webhookID }), nil
+}
+
+func (m *MockWebhookRepository) ListDeadLetters(limit int) ([]*webhook.Delivery, error) {
+	return m.list(limit, func(delivery *webhook.Delivery) bool { return delivery.Status == webhook.DeliveryDead }), nil
+}
+
+func (m *MockWebhookRepository) RequeueDelivery(id uuid.UUID, at time.Time) (*webhook.Delivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, delivery := range m.deliveries {
+		if delivery.ID == id && delivery.Status == webhook.DeliveryDead {
+			delivery.Status = webhook.DeliveryPending
+			delivery.Attempts = 0
+			delivery.NextAttemptAt = &at
+			delivery.UpdatedAt = at
+			copied := *delivery
+			return &copied, nil
+		}
+	}
+	return nil, webhook.ErrDeliveryNotFound
+}
+
+func (m *MockWebhookRepository) PurgeDelivered(before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kept := m.deliveries[:0]
+	for _, delivery := range m.deliveries {
+		if delivery.Status != webhook.DeliveryDelivered || !delivery.UpdatedAt.Before(before) {
+			kept = append(kept, delivery)
+		}
+	}
+	purged := int64(len(m.deliveries) - len(kept))
+	m.deliveries = kept
+	return purged, nil
+}
+
+func (m *MockWebhookRepository) hasDelivery(webhookID uuid.UUID, eventID int64) bool {
+	for _, delivery := range m.deliveries {
+		if delivery.WebhookID == webhookID && delivery.EventID == eventID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MockWebhookRepository) update(id uuid.UUID, fn func(delivery *webhook.Delivery)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, delivery := range m.deliveries {
+		if delivery.ID == id {
+			fn(delivery)
+			return nil
+		}
+	}
+	return webhook.ErrDeliveryNotFound
+}
+
+func (m *MockWebhookRepository) list(limit int, keep func(delivery *webhook.Delivery) bool) []*webhook.Delivery {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var deliveries []*webhook.Delivery
+	for i := len(m.deliveries) - 1; i >= 0 && len(deliveries) < limit; i-- {
+		if keep(m.deliveries[i]) {
+			copied := *m.deliveries[i]
+			deliveries = append(deliveries, &copied)
+		}
+	}
+	return deliveries
+}
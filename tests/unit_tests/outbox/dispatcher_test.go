@@ -0,0 +1,175 @@
+package outbox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/afornagieri/go_api_template/internal/infra/outbox"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+func setupDispatcher() (*outbox.Dispatcher, *mocks.MockOutboxRepository, *outbox.MemoryPublisher, *time.Time) {
+	repo := mocks.NewMockOutboxRepository()
+	publisher := outbox.NewMemoryPublisher()
+	dispatcher := outbox.NewDispatcher(repo, publisher)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	dispatcher.Now = func() time.Time { return now }
+	return dispatcher, repo, publisher, &now
+}
+
+func TestDispatcher_ShouldPublishAndMarkDelivered(t *testing.T) {
+	dispatcher, repo, publisher, _ := setupDispatcher()
+	first := repo.Add("item.created", "a", []byte(`{"name":"item1"}`))
+	second := repo.Add("item.created", "b", []byte(`{"name":"item2"}`))
+
+	delivered, err := dispatcher.DispatchPending(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, delivered)
+	messages := publisher.Messages()
+	assert.Len(t, messages, 2)
+	assert.Equal(t, first, messages[0].ID)
+	assert.Equal(t, "item.created", messages[0].Type)
+	assert.JSONEq(t, `{"name":"item1"}`, string(messages[0].Payload))
+	assert.NotNil(t, repo.Event(first).DeliveredAt)
+	assert.NotNil(t, repo.Event(second).DeliveredAt)
+
+	delivered, err = dispatcher.DispatchPending(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+}
+
+func TestDispatcher_ShouldRetryWithBackoff(t *testing.T) {
+	dispatcher, repo, publisher, now := setupDispatcher()
+	id := repo.Add("item.updated", "a", []byte(`{}`))
+	publisher.FailNext(2, errors.New("broker unavailable"))
+
+	dispatcher.DispatchPending(context.Background())
+	failed := repo.Event(id)
+	assert.Nil(t, failed.DeliveredAt)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, "broker unavailable", failed.LastError)
+	assert.Equal(t, now.Add(time.Second), failed.NextAttemptAt)
+
+	delivered, _ := dispatcher.DispatchPending(context.Background())
+	assert.Equal(t, 0, delivered)
+
+	*now = now.Add(time.Second)
+	dispatcher.DispatchPending(context.Background())
+	assert.Equal(t, now.Add(2*time.Second), repo.Event(id).NextAttemptAt)
+
+	*now = now.Add(2 * time.Second)
+	delivered, _ = dispatcher.DispatchPending(context.Background())
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, 3, repo.Event(id).Attempts)
+	assert.Len(t, publisher.Messages(), 1)
+}
+
+func TestDispatcher_ShouldKeepPerAggregateOrder(t *testing.T) {
+	dispatcher, repo, publisher, now := setupDispatcher()
+	created := repo.Add("item.created", "a", []byte(`{}`))
+	updated := repo.Add("item.updated", "a", []byte(`{}`))
+	other := repo.Add("item.created", "b", []byte(`{}`))
+	publisher.FailNext(1, errors.New("broker unavailable"))
+
+	delivered, err := dispatcher.DispatchPending(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Nil(t, repo.Event(updated).DeliveredAt)
+	assert.NotNil(t, repo.Event(other).DeliveredAt)
+
+	*now = now.Add(time.Minute)
+	dispatcher.DispatchPending(context.Background())
+	dispatcher.DispatchPending(context.Background())
+	messages := publisher.Messages()
+	assert.Equal(t, []int64{other, created, updated}, []int64{messages[0].ID, messages[1].ID, messages[2].ID})
+}
+
+func TestDispatcher_ShouldPurgeDeliveredEventsPastRetention(t *testing.T) {
+	dispatcher, repo, publisher, now := setupDispatcher()
+	dispatcher.Retention = 24 * time.Hour
+	old := repo.Add("item.created", "a", []byte(`{}`))
+	dispatcher.DispatchPending(context.Background())
+
+	*now = now.Add(12 * time.Hour)
+	pending := repo.Add("item.created", "b", []byte(`{}`))
+	recent := repo.Add("item.created", "c", []byte(`{}`))
+	publisher.FailNext(1, errors.New("broker unavailable"))
+	dispatcher.DispatchPending(context.Background())
+
+	*now = now.Add(13 * time.Hour)
+	purged, err := dispatcher.PurgeDelivered()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	assert.True(t, repo.Event(old).Purged)
+	assert.False(t, repo.Event(recent).Purged)
+	assert.False(t, repo.Event(pending).Purged)
+
+	dispatcher.Retention = 0
+	*now = now.Add(30 * 24 * time.Hour)
+	purged, err = dispatcher.PurgeDelivered()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), purged)
+	assert.False(t, repo.Event(recent).Purged)
+}
+
+func TestFanOut_ShouldOnlyRetryFailedTargets(t *testing.T) {
+	dispatcher, repo, _, now := setupDispatcher()
+	bus := outbox.NewMemoryPublisher()
+	webhooks := outbox.NewMemoryPublisher()
+	fanOut := outbox.NewFanOut(repo, outbox.Target{Name: "bus", Publisher: bus}, outbox.Target{Name: "webhooks", Publisher: webhooks})
+	fanOut.Now = dispatcher.Now
+	dispatcher.Publisher = fanOut
+	id := repo.Add("item.created", "a", []byte(`{}`))
+	webhooks.FailNext(1, errors.New("database is locked"))
+
+	delivered, _ := dispatcher.DispatchPending(context.Background())
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, "webhooks: database is locked", repo.Event(id).LastError)
+	published, _ := repo.PublishedTargets(id)
+	assert.Equal(t, []string{"bus"}, published)
+
+	*now = now.Add(time.Second)
+	delivered, err := dispatcher.DispatchPending(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Len(t, bus.Messages(), 1)
+	assert.Len(t, webhooks.Messages(), 1)
+	assert.NotNil(t, repo.Event(id).DeliveredAt)
+	published, _ = repo.PublishedTargets(id)
+	assert.Empty(t, published)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := outbox.ExponentialBackoff(time.Second, 10*time.Second)
+
+	assert.Equal(t, time.Second, backoff(1))
+	assert.Equal(t, 2*time.Second, backoff(2))
+	assert.Equal(t, 8*time.Second, backoff(4))
+	assert.Equal(t, 10*time.Second, backoff(5))
+	assert.Equal(t, 10*time.Second, backoff(50))
+}
+
+func TestWriterPublisher_ShouldWriteJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	publisher := outbox.NewWriterPublisher(&buf)
+
+	assert.NoError(t, publisher.Publish(context.Background(), outbox.Message{ID: 1, Type: "item.created", AggregateID: "a", Payload: json.RawMessage(`{"name":"item1"}`)}))
+	assert.NoError(t, publisher.Publish(context.Background(), outbox.Message{ID: 2, Type: "item.deleted", AggregateID: "a", Payload: json.RawMessage(`{"name":"item1"}`)}))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	assert.Len(t, lines, 2)
+	var msg outbox.Message
+	assert.NoError(t, json.Unmarshal(lines[1], &msg))
+	assert.Equal(t, int64(2), msg.ID)
+	assert.Equal(t, "item.deleted", msg.Type)
+}
@@ -0,0 +1,198 @@
+package repositories_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/category"
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+var categoryColumns = []string{"id", "name", "parent_id", "created_at"}
+
+func TestCategoryRepository_CreateCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewCategoryRepository(&database.SqlCli{Conn: db})
+	parentID := uuid.New()
+	c := &category.Category{ID: uuid.New(), Name: "Phones", ParentID: &parentID, CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+
+	t.Run("CreateCategory should insert below an existing parent", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM categories WHERE id = ?")).
+			WithArgs(parentID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM categories WHERE COALESCE(parent_id, '') = ? AND name = ? AND id != ?")).
+			WithArgs(parentID.String(), "Phones", c.ID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("INSERT INTO categories").
+			WithArgs(c.ID.String(), "Phones", parentID.String(), c.CreatedAt).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.CreateCategory(c))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateCategory should reject a missing parent", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM categories WHERE id = ?")).
+			WithArgs(parentID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectRollback()
+
+		err := repo.CreateCategory(c)
+		assert.ErrorIs(t, err, category.ErrInvalidCategory)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateCategory should reject a duplicate sibling", func(t *testing.T) {
+		root := &category.Category{ID: uuid.New(), Name: "Electronics", CreatedAt: c.CreatedAt}
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM categories WHERE COALESCE(parent_id, '') = ? AND name = ? AND id != ?")).
+			WithArgs("", "Electronics", root.ID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		err := repo.CreateCategory(root)
+		assert.ErrorIs(t, err, category.ErrCategoryAlreadyExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryRepository_UpdateCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewCategoryRepository(&database.SqlCli{Conn: db})
+	parentID := uuid.New()
+	c := &category.Category{ID: uuid.New(), Name: "Electronics", ParentID: &parentID}
+
+	t.Run("UpdateCategory should reject moving below a descendant", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM categories WHERE id = ?")).
+			WithArgs(parentID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM categories WHERE COALESCE(parent_id, '') = ?")).
+			WithArgs(parentID.String(), "Electronics", c.ID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery("WITH RECURSIVE ancestors").
+			WithArgs(parentID.String(), c.ID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		err := repo.UpdateCategory(c)
+		assert.ErrorIs(t, err, category.ErrCategoryCycle)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateCategory should report a missing category", func(t *testing.T) {
+		root := &category.Category{ID: uuid.New(), Name: "Electronics"}
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM categories WHERE COALESCE(parent_id, '') = ?")).
+			WithArgs("", "Electronics", root.ID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE categories SET name = ?, parent_id = ? WHERE id = ?")).
+			WithArgs("Electronics", nil, root.ID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.UpdateCategory(root)
+		assert.ErrorIs(t, err, category.ErrCategoryNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryRepository_DeleteCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewCategoryRepository(&database.SqlCli{Conn: db})
+	id := uuid.New()
+
+	t.Run("DeleteCategory should refuse a category with items", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT").
+			WithArgs(id.String(), id.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"children", "items"}).AddRow(0, 3))
+		mock.ExpectRollback()
+
+		err := repo.DeleteCategory(id)
+		assert.ErrorIs(t, err, category.ErrCategoryNotEmpty)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteCategory should delete an empty category", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT").
+			WithArgs(id.String(), id.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"children", "items"}).AddRow(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM categories WHERE id = ?")).
+			WithArgs(id.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.DeleteCategory(id))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCategoryRepository_ListItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewCategoryRepository(&database.SqlCli{Conn: db})
+	id := uuid.New()
+
+	t.Run("ListItems should walk the subtree", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, parent_id, created_at FROM categories WHERE id = ?")).
+			WithArgs(id.String()).
+			WillReturnRows(sqlmock.NewRows(categoryColumns).AddRow(id.String(), "Electronics", nil, updatedAt))
+		mock.ExpectQuery("WITH RECURSIVE tree").
+			WithArgs(id.String()).
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(uuid.New().String(), "phone", 300.0, "Smartphone", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil).
+				AddRow(uuid.New().String(), "tv", 500.0, "Television", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+
+		items, err := repo.ListItems(id, true)
+		assert.NoError(t, err)
+		assert.Len(t, items, 2)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListItems should report a missing category", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, parent_id, created_at FROM categories WHERE id = ?")).
+			WithArgs(id.String()).
+			WillReturnRows(sqlmock.NewRows(categoryColumns))
+
+		items, err := repo.ListItems(id, false)
+		assert.ErrorIs(t, err, category.ErrCategoryNotFound)
+		assert.Nil(t, items)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListItems should surface query errors", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, parent_id, created_at FROM categories WHERE id = ?")).
+			WithArgs(id.String()).
+			WillReturnRows(sqlmock.NewRows(categoryColumns).AddRow(id.String(), "Electronics", nil, updatedAt))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items WHERE id IN (SELECT item_id FROM item_categories WHERE category_id = ?)")).
+			WithArgs(id.String()).
+			WillReturnError(errors.New("database error"))
+
+		_, err := repo.ListItems(id, false)
+		assert.EqualError(t, err, "failed to fetch category items: database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,103 @@
+package repositories_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+func TestCurrencyRepository_Rates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewCurrencyRepository(&database.SqlCli{Conn: db})
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("SetRates should upsert every rate in one transaction", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO exchange_rates (.+) ON CONFLICT\\(currency\\) DO UPDATE").
+			WithArgs("EUR", 0.92, at).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO exchange_rates (.+) ON CONFLICT\\(currency\\) DO UPDATE").
+			WithArgs("GBP", 0.79, at).
+			WillReturnError(errors.New("database error"))
+		mock.ExpectRollback()
+
+		err := repo.SetRates([]*entities.ExchangeRate{
+			{Currency: "EUR", Rate: 0.92, UpdatedAt: at},
+			{Currency: "GBP", Rate: 0.79, UpdatedAt: at},
+		})
+		assert.EqualError(t, err, "failed to save exchange rate: database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetRate should report missing rates", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT currency, rate, updated_at FROM exchange_rates WHERE currency = ?")).
+			WithArgs("GBP").
+			WillReturnRows(sqlmock.NewRows([]string{"currency", "rate", "updated_at"}))
+
+		_, err := repo.GetRate("GBP")
+		assert.ErrorIs(t, err, entities.ErrExchangeRateNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteRate should report missing rates", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM exchange_rates WHERE currency = ?")).
+			WithArgs("GBP").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.DeleteRate("GBP")
+		assert.ErrorIs(t, err, entities.ErrExchangeRateNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCurrencyRepository_ItemPrices(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewCurrencyRepository(&database.SqlCli{Conn: db})
+	itemID := uuid.New()
+
+	t.Run("PricesIn should map fixed prices by item", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT item_id, price FROM item_currency_prices WHERE currency = ?")).
+			WithArgs("EUR").
+			WillReturnRows(sqlmock.NewRows([]string{"item_id", "price"}).AddRow(itemID.String(), 9.5))
+
+		prices, err := repo.PricesIn("EUR")
+		assert.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]float64{itemID: 9.5}, prices)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SetItemPrice should upsert the price", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO item_currency_prices (.+) ON CONFLICT\\(item_id, currency\\) DO UPDATE").
+			WithArgs(itemID.String(), "EUR", 9.5).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.SetItemPrice(itemID, &entities.CurrencyPrice{Currency: "EUR", Price: 9.5})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteItemPrice should report missing prices", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM item_currency_prices WHERE item_id = ? AND currency = ?")).
+			WithArgs(itemID.String(), "JPY").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.DeleteItemPrice(itemID, "JPY")
+		assert.ErrorIs(t, err, entities.ErrCurrencyPriceNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
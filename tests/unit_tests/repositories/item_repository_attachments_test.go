@@ -0,0 +1,58 @@
+package repositories_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+func TestItemRepository_Attachments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	itemID := uuid.New()
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	attachment := &entities.Attachment{ID: uuid.New(), Filename: "spec.pdf", ContentType: "application/pdf", Size: 9, Checksum: "abc", CreatedAt: at, CreatedBy: "alice"}
+
+	t.Run("AddAttachment should only insert for existing items", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO item_attachments (item_id, id, filename, content_type, size, checksum, created_at, created_by)")).
+			WithArgs(attachment.ID.String(), "spec.pdf", "application/pdf", int64(9), "abc", at, "alice", itemID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.AddAttachment(itemID, attachment)
+		assert.ErrorIs(t, err, entities.ErrItemNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListAttachments should return attachments oldest first", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_attachments WHERE item_id = ? ORDER BY created_at, id")).
+			WithArgs(itemID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "filename", "content_type", "size", "checksum", "created_at", "created_by"}).
+				AddRow(attachment.ID.String(), "spec.pdf", "application/pdf", 9, "abc", at, "alice"))
+
+		attachments, err := repo.ListAttachments(itemID)
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.Attachment{attachment}, attachments)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteAttachment should report missing attachments", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM item_attachments WHERE item_id = ? AND id = ?")).
+			WithArgs(itemID.String(), attachment.ID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.DeleteAttachment(itemID, attachment.ID)
+		assert.ErrorIs(t, err, entities.ErrAttachmentNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
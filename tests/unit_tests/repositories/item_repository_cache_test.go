@@ -0,0 +1,179 @@
+package repositories_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/cache"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+type countingRepository struct {
+	*mocks.MockItemRepository
+	calls atomic.Int64
+	gate  chan struct{}
+}
+
+func (repo *countingRepository) GetItemByName(name string) (*entities.Item, error) {
+	repo.calls.Add(1)
+	if repo.gate != nil {
+		<-repo.gate
+	}
+	return repo.MockItemRepository.GetItemByName(name)
+}
+
+func (repo *countingRepository) GetItemByID(id uuid.UUID) (*entities.Item, error) {
+	repo.calls.Add(1)
+	return repo.MockItemRepository.GetItemByID(id)
+}
+
+type staleRepository struct {
+	*mocks.MockItemRepository
+	stale   atomic.Bool
+	fetched chan struct{}
+	release chan struct{}
+}
+
+func (repo *staleRepository) GetItemByName(name string) (*entities.Item, error) {
+	item, err := repo.MockItemRepository.GetItemByName(name)
+	if err != nil || !repo.stale.CompareAndSwap(true, false) {
+		return item, err
+	}
+	copied := *item
+	close(repo.fetched)
+	<-repo.release
+	return &copied, nil
+}
+
+type failingCache struct{}
+
+func (failingCache) Get(key string) ([]byte, bool, error) {
+	return nil, false, errors.New("unavailable")
+}
+func (failingCache) Set(key string, value []byte, ttl time.Duration) error {
+	return errors.New("unavailable")
+}
+func (failingCache) Delete(keys ...string) error { return errors.New("unavailable") }
+
+func setupCachingRepository() (*repositories.CachingItemRepository, *countingRepository) {
+	backend := &countingRepository{MockItemRepository: mocks.NewMockItemRepository()}
+	return repositories.NewCachingItemRepository(backend, cache.NewLRU(100), time.Minute), backend
+}
+
+func TestCachingItemRepository_ShouldServeRepeatedLookupsFromCache(t *testing.T) {
+	repo, backend := setupCachingRepository()
+	created, _ := repo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	for i := 0; i < 3; i++ {
+		item, err := repo.GetItemByName("item1")
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, item.ID)
+	}
+	repo.GetItemByID(created.ID)
+	repo.GetItemByID(created.ID)
+
+	assert.Equal(t, int64(2), backend.calls.Load())
+	assert.Equal(t, repositories.CacheStats{Hits: 3, Misses: 2}, repo.Stats())
+}
+
+func TestCachingItemRepository_ShouldInvalidateOnWrites(t *testing.T) {
+	repo, backend := setupCachingRepository()
+	created, _ := repo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	repo.GetItemByName("item1")
+	repo.GetItemByID(created.ID)
+
+	repo.UpdateItem("item1", &entities.Item{Name: "renamed", Price: 20.0, Description: "Description1"})
+
+	_, err := repo.GetItemByName("item1")
+	assert.ErrorIs(t, err, entities.ErrItemNotFound)
+	item, _ := repo.GetItemByID(created.ID)
+	assert.Equal(t, "renamed", item.Name)
+	assert.Equal(t, 20.0, item.Price)
+
+	repo.DeleteItem("renamed")
+
+	_, err = repo.GetItemByID(created.ID)
+	assert.ErrorIs(t, err, entities.ErrItemNotFound)
+	assert.Equal(t, int64(6), backend.calls.Load())
+}
+
+func TestCachingItemRepository_ShouldNotCacheMisses(t *testing.T) {
+	repo, backend := setupCachingRepository()
+
+	_, err := repo.GetItemByName("missing")
+	assert.ErrorIs(t, err, entities.ErrItemNotFound)
+	repo.CreateItem(&entities.Item{Name: "missing", Price: 10.0, Description: "Description1"})
+
+	item, err := repo.GetItemByName("missing")
+	assert.NoError(t, err)
+	assert.Equal(t, "missing", item.Name)
+	assert.Equal(t, int64(2), backend.calls.Load())
+}
+
+func TestCachingItemRepository_ShouldCoalesceConcurrentMisses(t *testing.T) {
+	repo, backend := setupCachingRepository()
+	repo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	backend.gate = make(chan struct{})
+
+	const callers = 10
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			item, err := repo.GetItemByName("item1")
+			assert.NoError(t, err)
+			assert.Equal(t, "item1", item.Name)
+		}()
+	}
+	assert.Eventually(t, func() bool { return repo.Stats().Misses == callers }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(backend.gate)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), backend.calls.Load())
+	assert.Equal(t, uint64(callers), repo.Stats().Coalesced)
+}
+
+func TestCachingItemRepository_ShouldNotCacheFetchesThatRaceAWrite(t *testing.T) {
+	backend := &staleRepository{MockItemRepository: mocks.NewMockItemRepository(), fetched: make(chan struct{}), release: make(chan struct{})}
+	repo := repositories.NewCachingItemRepository(backend, cache.NewLRU(100), time.Minute)
+	repo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+	backend.stale.Store(true)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		item, err := repo.GetItemByName("item1")
+		assert.NoError(t, err)
+		assert.Equal(t, 10.0, item.Price)
+	}()
+	<-backend.fetched
+	repo.UpdateItem("item1", &entities.Item{Name: "item1", Price: 20.0, Description: "Description1"})
+	close(backend.release)
+	<-done
+
+	item, err := repo.GetItemByName("item1")
+	assert.NoError(t, err)
+	assert.Equal(t, 20.0, item.Price)
+}
+
+func TestCachingItemRepository_ShouldFallBackWhenCacheFails(t *testing.T) {
+	backend := &countingRepository{MockItemRepository: mocks.NewMockItemRepository()}
+	repo := repositories.NewCachingItemRepository(backend, failingCache{}, time.Minute)
+	repo.CreateItem(&entities.Item{Name: "item1", Price: 10.0, Description: "Description1"})
+
+	item, err := repo.GetItemByName("item1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "item1", item.Name)
+	assert.Equal(t, uint64(3), repo.Stats().Errors)
+}
@@ -0,0 +1,153 @@
+package repositories_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+var priceColumns = []string{"id", "item_id", "price", "effective_from", "applied_at", "created_at", "created_by"}
+
+func TestItemRepository_PriceHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	itemID := uuid.New()
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("UpdateItem should not record a price change when the price is unchanged", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("item1").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "item1", 10.0, "Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+		mock.ExpectExec("UPDATE items").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		_, err := repo.UpdateItem("item1", &entities.Item{Name: "item1", Price: 10.0, Description: "Changed", UpdatedAt: at, UpdatedBy: "bob"})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetPriceAt should return the latest change effective at the time", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_prices WHERE item_id = ? AND effective_from <= ? ORDER BY effective_from DESC, rowid DESC LIMIT 1")).
+			WithArgs(itemID.String(), at).
+			WillReturnRows(sqlmock.NewRows(priceColumns).
+				AddRow(uuid.New().String(), itemID.String(), 12.0, at.Add(-time.Hour), at.Add(-time.Hour), at.Add(-time.Hour), "alice"))
+
+		change, err := repo.GetPriceAt(itemID, at)
+		assert.NoError(t, err)
+		assert.Equal(t, 12.0, change.Price)
+		assert.False(t, change.Pending())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetPriceAt should report items without history", func(t *testing.T) {
+		mock.ExpectQuery("FROM item_prices").
+			WillReturnRows(sqlmock.NewRows(priceColumns))
+
+		_, err := repo.GetPriceAt(itemID, at)
+		assert.True(t, errors.Is(err, entities.ErrPriceChangeNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SchedulePriceChange should store a pending change", func(t *testing.T) {
+		change := &entities.PriceChange{ID: uuid.New(), ItemID: itemID, Price: 15.0, EffectiveFrom: at.Add(time.Hour), CreatedAt: at, CreatedBy: "bob"}
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM items WHERE id = ?")).
+			WithArgs(itemID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectExec("INSERT INTO item_prices").
+			WithArgs(change.ID.String(), itemID.String(), 15.0, at.Add(time.Hour), nil, at, "bob").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		assert.NoError(t, repo.SchedulePriceChange(change))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CancelPriceChange should only cancel pending changes", func(t *testing.T) {
+		id := uuid.New()
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM item_prices WHERE id = ? AND item_id = ? AND applied_at IS NULL")).
+			WithArgs(id.String(), itemID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.CancelPriceChange(itemID, id)
+		assert.True(t, errors.Is(err, entities.ErrPriceChangeNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_ApplyPriceChange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	itemID, changeID := uuid.New(), uuid.New()
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("DuePriceChanges should return pending changes in effective order", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_prices WHERE applied_at IS NULL AND effective_from <= ? ORDER BY effective_from, rowid LIMIT ?")).
+			WithArgs(at, 10).
+			WillReturnRows(sqlmock.NewRows(priceColumns).
+				AddRow(changeID.String(), itemID.String(), 15.0, at.Add(-time.Minute), nil, at.Add(-time.Hour), "bob"))
+
+		due, err := repo.DuePriceChanges(at, 10)
+		assert.NoError(t, err)
+		assert.Len(t, due, 1)
+		assert.True(t, due[0].Pending())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ApplyPriceChange should update the item and mark the change applied", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_prices WHERE id = ? AND applied_at IS NULL")).
+			WithArgs(changeID.String()).
+			WillReturnRows(sqlmock.NewRows(priceColumns).
+				AddRow(changeID.String(), itemID.String(), 15.0, at.Add(-time.Minute), nil, at.Add(-time.Hour), "bob"))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE item_prices SET applied_at = ? WHERE id = ?")).
+			WithArgs(at, changeID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE items SET price = ?, updated_at = ?, updated_by = ? WHERE id = ?")).
+			WithArgs(15.0, at, "bob", itemID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE id = ?").
+			WithArgs(itemID.String()).
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "item1", 15.0, "Description", updatedAt, "alice", at, "bob", "USD", "", nil, "active", nil))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WithArgs("item.updated", itemID.String(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		item, err := repo.ApplyPriceChange(changeID, at)
+		assert.NoError(t, err)
+		assert.Equal(t, 15.0, item.Price)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ApplyPriceChange should skip changes that are no longer pending", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("FROM item_prices").
+			WillReturnRows(sqlmock.NewRows(priceColumns))
+		mock.ExpectRollback()
+
+		_, err := repo.ApplyPriceChange(changeID, at)
+		assert.True(t, errors.Is(err, entities.ErrPriceChangeNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,199 @@
+package repositories_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+var stockColumns = []string{"on_hand", "reserved"}
+
+func TestItemRepository_AdjustStock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	itemID := uuid.New()
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("AdjustStock should apply the delta and record a movement", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO item_stock (item_id, on_hand, updated_at) VALUES (?, 0, ?) ON CONFLICT(item_id) DO NOTHING")).
+			WithArgs(itemID.String(), at).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE item_stock SET on_hand = on_hand + ?, updated_at = ? WHERE item_id = ? AND on_hand + ? >= (SELECT COALESCE(SUM(quantity), 0) FROM stock_reservations")).
+			WithArgs(10, at, itemID.String(), 10, itemID.String(), at).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT on_hand FROM item_stock WHERE item_id = ?), 0)")).
+			WithArgs(itemID.String(), itemID.String(), at).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(10, 2))
+		mock.ExpectExec("INSERT INTO stock_movements").
+			WithArgs(sqlmock.AnyArg(), itemID.String(), "receive", 10, 10, "PO-1", nil, at, "alice").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		stock, err := repo.AdjustStock(itemID, entities.MovementReceive, 10, "PO-1", "alice", at)
+		assert.NoError(t, err)
+		assert.Equal(t, &entities.Stock{ItemID: itemID, OnHand: 10, Reserved: 2, Available: 8}, stock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AdjustStock should refuse to go below the reserved quantity", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO item_stock").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("UPDATE item_stock SET on_hand = on_hand").
+			WithArgs(-9, at, itemID.String(), -9, itemID.String(), at).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT on_hand FROM item_stock WHERE item_id = ?), 0)")).
+			WithArgs(itemID.String(), itemID.String(), at).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(10, 2))
+		mock.ExpectRollback()
+
+		_, err := repo.AdjustStock(itemID, entities.MovementShip, -9, "", "alice", at)
+		assert.True(t, errors.Is(err, entities.ErrInsufficientStock))
+		assert.Contains(t, err.Error(), "only 8 available")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_CorrectStock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	itemID := uuid.New()
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("CorrectStock should record the difference", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO item_stock").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT on_hand FROM item_stock WHERE item_id = ?), 0)")).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(10, 0))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE item_stock SET on_hand = ?, updated_at = ? WHERE item_id = ?")).
+			WithArgs(7, at, itemID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO stock_movements").
+			WithArgs(sqlmock.AnyArg(), itemID.String(), "correct", -3, 7, "cycle count", nil, at, "alice").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		stock, err := repo.CorrectStock(itemID, 7, "cycle count", "alice", at)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, stock.Available)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CorrectStock should keep reserved units", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO item_stock").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT on_hand FROM item_stock WHERE item_id = ?), 0)")).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(10, 4))
+		mock.ExpectRollback()
+
+		_, err := repo.CorrectStock(itemID, 3, "cycle count", "alice", at)
+		assert.True(t, errors.Is(err, entities.ErrInsufficientStock))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_Reservations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	reservation := &entities.Reservation{ID: uuid.New(), ItemID: uuid.New(), Quantity: 3, ExpiresAt: now.Add(time.Minute), CreatedAt: now, CreatedBy: "alice"}
+	reservationColumns := []string{"id", "item_id", "quantity", "expires_at", "created_at", "created_by"}
+
+	t.Run("Reserve should purge expired reservations and hold available units", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM stock_reservations WHERE item_id = ? AND expires_at <= ?")).
+			WithArgs(reservation.ItemID.String(), now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO stock_reservations (id, item_id, quantity, expires_at, created_at, created_by) SELECT ?, ?, ?, ?, ?, ? WHERE")).
+			WithArgs(reservation.ID.String(), reservation.ItemID.String(), 3, reservation.ExpiresAt, now, "alice",
+				reservation.ItemID.String(), reservation.ItemID.String(), now, 3).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.Reserve(reservation))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reserve should fail when not enough units are available", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM stock_reservations").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO stock_reservations").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT on_hand FROM item_stock WHERE item_id = ?), 0)")).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(4, 2))
+		mock.ExpectRollback()
+
+		err := repo.Reserve(reservation)
+		assert.True(t, errors.Is(err, entities.ErrInsufficientStock))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetReservation should ignore expired reservations", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM stock_reservations WHERE id = ? AND expires_at > ?")).
+			WithArgs(reservation.ID.String(), now).
+			WillReturnRows(sqlmock.NewRows(reservationColumns))
+
+		_, err := repo.GetReservation(reservation.ID, now)
+		assert.True(t, errors.Is(err, entities.ErrReservationNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ReleaseReservation should report unknown reservations", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM stock_reservations WHERE id = ? AND expires_at > ?")).
+			WithArgs(reservation.ID.String(), now).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.ReleaseReservation(reservation.ID, now)
+		assert.True(t, errors.Is(err, entities.ErrReservationNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CommitReservation should ship the reserved units", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("FROM stock_reservations WHERE id = ? AND expires_at > ?")).
+			WithArgs(reservation.ID.String(), now).
+			WillReturnRows(sqlmock.NewRows(reservationColumns).
+				AddRow(reservation.ID.String(), reservation.ItemID.String(), 3, reservation.ExpiresAt, now, "alice"))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM stock_reservations WHERE id = ?")).
+			WithArgs(reservation.ID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO item_stock").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("UPDATE item_stock SET on_hand = on_hand").
+			WithArgs(-3, now, reservation.ItemID.String(), -3, reservation.ItemID.String(), now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE((SELECT on_hand FROM item_stock WHERE item_id = ?), 0)")).
+			WillReturnRows(sqlmock.NewRows(stockColumns).AddRow(1, 0))
+		mock.ExpectExec("INSERT INTO stock_movements").
+			WithArgs(sqlmock.AnyArg(), reservation.ItemID.String(), "ship", -3, 1, "", reservation.ID.String(), now, "bob").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		stock, err := repo.CommitReservation(reservation.ID, "bob", now)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, stock.OnHand)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
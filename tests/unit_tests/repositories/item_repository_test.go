@@ -4,7 +4,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -15,6 +17,11 @@ import (
 	"github.com/afornagieri/go_api_template/internal/infra/repositories"
 )
 
+var (
+	updatedAt   = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	itemColumns = []string{"id", "name", "price", "description", "created_at", "created_by", "updated_at", "updated_by", "currency", "type", "attributes", "status", "tags"}
+)
+
 func TestItemRepository_GetItems(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
@@ -24,10 +31,10 @@ func TestItemRepository_GetItems(t *testing.T) {
 	repo := repositories.NewItemRepository(sqlCli)
 
 	t.Run("GetItems should return items successfully", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "name", "price", "description"}).
-			AddRow(uuid.New().String(), "Item1", 100.0, "Description1").
-			AddRow(uuid.New().String(), "Item2", 150.0, "Description2")
-		mock.ExpectQuery("SELECT id, name, price, description FROM items").
+		rows := sqlmock.NewRows(itemColumns).
+			AddRow(uuid.New().String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil).
+			AddRow(uuid.New().String(), "Item2", 150.0, "Description2", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil)
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items").
 			WillReturnRows(rows)
 
 		items, err := repo.GetItems()
@@ -37,7 +44,7 @@ func TestItemRepository_GetItems(t *testing.T) {
 	})
 
 	t.Run("GetItems should handle database error", func(t *testing.T) {
-		mock.ExpectQuery("SELECT id, name, price, description FROM items").
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items").
 			WillReturnError(errors.New("database error"))
 
 		items, err := repo.GetItems()
@@ -48,10 +55,10 @@ func TestItemRepository_GetItems(t *testing.T) {
 	})
 
 	t.Run("GetItems should handle scanning error", func(t *testing.T) {
-		mock.ExpectQuery("SELECT id, name, price, description FROM items").
-			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "description"}).
-				AddRow(uuid.New().String(), "Item1", 100.0, "Description1").
-				AddRow(uuid.New().String(), "Item2", "invalid_price", "Description2"))
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(uuid.New().String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil).
+				AddRow(uuid.New().String(), "Item2", "invalid_price", "Description2", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
 
 		items, err := repo.GetItems()
 		assert.Error(t, err)
@@ -71,9 +78,9 @@ func TestItemRepository_GetItemByName(t *testing.T) {
 
 	t.Run("GetItemByName should return item successfully", func(t *testing.T) {
 		itemName := "Item1"
-		rows := sqlmock.NewRows([]string{"id", "name", "price", "description"}).
-			AddRow(uuid.New().String(), itemName, 100.0, "Description1")
-		mock.ExpectQuery("SELECT id, name, price, description FROM items WHERE name = ?").
+		rows := sqlmock.NewRows(itemColumns).
+			AddRow(uuid.New().String(), itemName, 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil)
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
 			WithArgs(itemName).
 			WillReturnRows(rows)
 
@@ -86,7 +93,7 @@ func TestItemRepository_GetItemByName(t *testing.T) {
 
 	t.Run("GetItemByName should handle item not found", func(t *testing.T) {
 		itemName := "NonExistingItem"
-		mock.ExpectQuery("SELECT id, name, price, description FROM items WHERE name = ?").
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
 			WithArgs(itemName).
 			WillReturnError(sql.ErrNoRows)
 
@@ -94,12 +101,13 @@ func TestItemRepository_GetItemByName(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, item)
 		assert.EqualError(t, err, fmt.Sprintf("item '%s' not found: %v", itemName, sql.ErrNoRows))
+		assert.ErrorIs(t, err, entities.ErrItemNotFound)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("GetItemByName should handle database error", func(t *testing.T) {
 		itemName := "Item1"
-		mock.ExpectQuery("SELECT id, name, price, description FROM items WHERE name = ?").
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
 			WithArgs(itemName).
 			WillReturnError(errors.New("database error"))
 
@@ -128,12 +136,20 @@ func TestItemRepository_CreateItem(t *testing.T) {
 
 		mock.ExpectBegin()
 		mock.ExpectExec("INSERT INTO items").
-			WithArgs(sqlmock.AnyArg(), item.Name, item.Price, item.Description).
+			WithArgs(sqlmock.AnyArg(), item.Name, item.Price, item.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), entities.BaseCurrency, "", nil, entities.StatusActive).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO item_prices").
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), item.Price, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WithArgs("item.created", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
-		err := repo.CreateItem(item)
+		created, err := repo.CreateItem(item)
 		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, created.ID)
+		assert.Equal(t, item.Name, created.Name)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -146,7 +162,7 @@ func TestItemRepository_CreateItem(t *testing.T) {
 
 		mock.ExpectBegin().WillReturnError(errors.New("could not begin transaction:"))
 
-		err := repo.CreateItem(item)
+		_, err := repo.CreateItem(item)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "could not begin transaction")
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -159,7 +175,7 @@ func TestItemRepository_CreateItem(t *testing.T) {
 			Description: "Description for NewItem",
 		}
 		mock.ExpectBegin()
-		err := repo.CreateItem(item)
+		_, err := repo.CreateItem(item)
 		assert.Error(t, err)
 		assert.EqualError(t, err, "failed to create new item: name is required")
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -172,7 +188,7 @@ func TestItemRepository_CreateItem(t *testing.T) {
 			Description: "Description for NewItem",
 		}
 		mock.ExpectBegin()
-		err := repo.CreateItem(item)
+		_, err := repo.CreateItem(item)
 		assert.Error(t, err)
 		assert.EqualError(t, err, "failed to create new item: price must be greater than 0")
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -185,7 +201,7 @@ func TestItemRepository_CreateItem(t *testing.T) {
 			Description: "",
 		}
 		mock.ExpectBegin()
-		err := repo.CreateItem(item)
+		_, err := repo.CreateItem(item)
 		assert.Error(t, err)
 		assert.EqualError(t, err, "failed to create new item: description is required")
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -200,14 +216,32 @@ func TestItemRepository_CreateItem(t *testing.T) {
 
 		mock.ExpectBegin()
 		mock.ExpectExec("INSERT INTO items").
-			WithArgs(sqlmock.AnyArg(), item.Name, item.Price, item.Description).
+			WithArgs(sqlmock.AnyArg(), item.Name, item.Price, item.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), entities.BaseCurrency, "", nil, entities.StatusActive).
 			WillReturnError(errors.New("failed to insert item:"))
+		mock.ExpectRollback()
 
-		err := repo.CreateItem(item)
+		_, err := repo.CreateItem(item)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to insert item:")
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
+
+	t.Run("CreateItem should report a duplicate name", func(t *testing.T) {
+		item := &entities.Item{
+			Name:        "NewItem",
+			Price:       200.0,
+			Description: "Description for NewItem",
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO items").
+			WillReturnError(errors.New("UNIQUE constraint failed: items.name"))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateItem(item)
+		assert.ErrorIs(t, err, entities.ErrItemAlreadyExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestItemRepository_UpdateItem(t *testing.T) {
@@ -220,6 +254,7 @@ func TestItemRepository_UpdateItem(t *testing.T) {
 
 	t.Run("UpdateItem should update an existing item successfully", func(t *testing.T) {
 		existingItemName := "ExistingItem"
+		existingID := uuid.New()
 		item := &entities.Item{
 			Name:        "UpdatedItem",
 			Price:       300.0,
@@ -227,17 +262,49 @@ func TestItemRepository_UpdateItem(t *testing.T) {
 		}
 
 		mock.ExpectBegin()
-		mock.ExpectQuery("SELECT id, name, price, description FROM items WHERE name = ?").
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
 			WithArgs(existingItemName).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "description"}).
-				AddRow(uuid.New().String(), existingItemName, 200.0, "Original Description"))
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(existingID.String(), existingItemName, 200.0, "Original Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
 		mock.ExpectExec("UPDATE items").
-			WithArgs(item.Name, item.Price, item.Description, existingItemName).
+			WithArgs(item.Name, item.Price, "USD", item.Description, "", nil, sqlmock.AnyArg(), sqlmock.AnyArg(), existingItemName).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO item_prices").
+			WithArgs(sqlmock.AnyArg(), existingID.String(), item.Price, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WithArgs("item.updated", existingID.String(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
-		err := repo.UpdateItem(existingItemName, item)
+		updated, err := repo.UpdateItem(existingItemName, item)
 		assert.NoError(t, err)
+		assert.Equal(t, existingID, updated.ID)
+		assert.Equal(t, item.Name, updated.Name)
+		assert.Equal(t, updatedAt, updated.CreatedAt)
+		assert.Equal(t, "alice", updated.CreatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateItem should report a rename onto an existing name", func(t *testing.T) {
+		existingID := uuid.New()
+		item := &entities.Item{
+			Name:        "TakenItem",
+			Price:       200.0,
+			Description: "Original Description",
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("ExistingItem").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(existingID.String(), "ExistingItem", 200.0, "Original Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+		mock.ExpectExec("UPDATE items").
+			WillReturnError(errors.New("UNIQUE constraint failed: items.name"))
+		mock.ExpectRollback()
+
+		_, err := repo.UpdateItem("ExistingItem", item)
+		assert.ErrorIs(t, err, entities.ErrItemAlreadyExists)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -250,7 +317,7 @@ func TestItemRepository_UpdateItem(t *testing.T) {
 
 		mock.ExpectBegin().WillReturnError(errors.New("could not begin transaction:"))
 
-		err := repo.UpdateItem("item", item)
+		_, err := repo.UpdateItem("item", item)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "could not begin transaction")
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -265,12 +332,12 @@ func TestItemRepository_UpdateItem(t *testing.T) {
 		}
 
 		mock.ExpectBegin()
-		mock.ExpectQuery("SELECT id, name, price, description FROM items WHERE name = ?").
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
 			WithArgs(nonExistingItemName).
 			WillReturnError(sql.ErrNoRows)
 		mock.ExpectRollback()
 
-		err := repo.UpdateItem(nonExistingItemName, item)
+		_, err := repo.UpdateItem(nonExistingItemName, item)
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
@@ -284,16 +351,16 @@ func TestItemRepository_UpdateItem(t *testing.T) {
 		}
 
 		mock.ExpectBegin()
-		mock.ExpectQuery("SELECT id, name, price, description FROM items WHERE name = ?").
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
 			WithArgs(itemName).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "price", "description"}).
-				AddRow(uuid.New().String(), itemName, 200.0, "Original Description"))
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(uuid.New().String(), itemName, 200.0, "Original Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
 		mock.ExpectExec("UPDATE items").
-			WithArgs(item.Name, item.Price, item.Description, itemName).
+			WithArgs(item.Name, item.Price, "USD", item.Description, "", nil, sqlmock.AnyArg(), sqlmock.AnyArg(), itemName).
 			WillReturnError(errors.New("database error"))
 		mock.ExpectRollback()
 
-		err := repo.UpdateItem(itemName, item)
+		_, err := repo.UpdateItem(itemName, item)
 		assert.Error(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("failed to update item: %v", errors.New("database error")))
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -310,11 +377,46 @@ func TestItemRepository_DeleteItem(t *testing.T) {
 
 	t.Run("DeleteItem should delete item successfully", func(t *testing.T) {
 		itemName := "ItemToDelete"
+		existingID := uuid.New()
 
 		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs(itemName).
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(existingID.String(), itemName, 200.0, "Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
 		mock.ExpectExec("DELETE FROM items WHERE name = ?").
 			WithArgs(itemName).
 			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("DELETE FROM item_categories WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("DELETE FROM item_tags WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM stock_reservations WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM item_stock WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM item_prices WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("DELETE FROM item_currency_prices WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM item_translations WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM item_attachments WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM item_variants WHERE item_id = ?").
+			WithArgs(existingID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WithArgs("item.deleted", existingID.String(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
 		err := repo.DeleteItem(itemName)
@@ -322,6 +424,20 @@ func TestItemRepository_DeleteItem(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("DeleteItem should report missing item", func(t *testing.T) {
+		itemName := "MissingItem"
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs(itemName).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err := repo.DeleteItem(itemName)
+		assert.ErrorIs(t, err, entities.ErrItemNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("DeleteItem should handle database begin transaction error", func(t *testing.T) {
 		mock.ExpectBegin().WillReturnError(errors.New("could not begin transaction:"))
 
@@ -335,6 +451,10 @@ func TestItemRepository_DeleteItem(t *testing.T) {
 		itemName := "ItemToDelete"
 
 		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs(itemName).
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(uuid.New().String(), itemName, 200.0, "Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
 		mock.ExpectExec("DELETE FROM items WHERE name = ?").
 			WithArgs(itemName).
 			WillReturnError(errors.New("database error"))
@@ -346,3 +466,395 @@ func TestItemRepository_DeleteItem(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestItemRepository_ListItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlCli := &database.SqlCli{Conn: db}
+	repo := repositories.NewItemRepository(sqlCli)
+
+	t.Run("ListItems should filter by audit fields and sort by the requested key", func(t *testing.T) {
+		since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items WHERE name > ? AND created_at > ? AND updated_by = ? ORDER BY updated_at DESC, name")).
+			WithArgs("", since.UTC(), "bob").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(uuid.New().String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "bob", "USD", "", nil, "active", nil))
+
+		items, err := repo.ListItems(entities.ItemQuery{
+			CreatedAfter: &since,
+			UpdatedBy:    "bob",
+			SortBy:       entities.SortByUpdatedAt,
+			Descending:   true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, items, 1)
+		assert.Equal(t, "bob", items[0].UpdatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListItems should require every tag by default", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items WHERE name > ? AND id IN (SELECT item_id FROM item_tags WHERE tag IN (?, ?) GROUP BY item_id HAVING COUNT(*) = ?) ORDER BY name")).
+			WithArgs("", "fragile", "seasonal", 2).
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(uuid.New().String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", "seasonal,fragile"))
+
+		items, err := repo.ListItems(entities.ItemQuery{Tags: []string{"fragile", "seasonal"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"fragile", "seasonal"}, items[0].Tags)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListItems should accept any tag when asked to", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items WHERE name > ? AND id IN (SELECT item_id FROM item_tags WHERE tag IN (?, ?)) ORDER BY name")).
+			WithArgs("", "fragile", "seasonal").
+			WillReturnRows(sqlmock.NewRows(itemColumns))
+
+		_, err := repo.ListItems(entities.ItemQuery{Tags: []string{"fragile", "seasonal"}, MatchAnyTag: true})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListItems should filter on the type and attributes through JSON functions", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items WHERE name > ? AND type = ? AND json_extract(attributes, ?) IN (?, ?) "+
+			"AND json_type(attributes, ?) IN ('integer', 'real') AND json_extract(attributes, ?) >= ? ORDER BY name")).
+			WithArgs("", "electronics", "$.plug", "eu", "eu", "$.voltage", "$.voltage", 110.0).
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(uuid.New().String(), "lamp", 10.0, "Lamp", updatedAt, "alice", updatedAt, "alice", "USD", "electronics", `{"plug":"eu","voltage":230}`, "active", nil))
+
+		items, err := repo.ListItems(entities.ItemQuery{
+			Type: "electronics",
+			Attributes: []entities.AttributeFilter{
+				{Name: "plug", Operator: entities.AttributeEquals, Value: "eu", Raw: "eu"},
+				{Name: "voltage", Operator: entities.AttributeGreaterOrEqual, Value: 110.0, Raw: "110"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "electronics", items[0].Type)
+		assert.JSONEq(t, `{"plug":"eu","voltage":230}`, string(items[0].Attributes))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListItems should filter on the status", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items WHERE name > ? AND status IN (?, ?) ORDER BY name")).
+			WithArgs("", "draft", "discontinued").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(uuid.New().String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "draft", nil))
+
+		items, err := repo.ListItems(entities.ItemQuery{Statuses: []string{entities.StatusDraft, entities.StatusDiscontinued}})
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusDraft, items[0].Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListItems should order by name by default", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items WHERE name > ? ORDER BY name")).
+			WithArgs("").
+			WillReturnRows(sqlmock.NewRows(itemColumns))
+
+		_, err := repo.ListItems(entities.ItemQuery{})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_SetItemStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	itemID := uuid.New()
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("SetItemStatus should move the item and stamp it", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("item1").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "item1", 10.0, "Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "draft", nil))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE items SET status = ? WHERE id = ? AND status = ?")).
+			WithArgs("active", itemID.String(), "draft").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE items SET updated_at = \\?, updated_by = \\?").
+			WithArgs(at, "bob", itemID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		item, err := repo.SetItemStatus("item1", entities.StatusDraft, entities.StatusActive, "bob", at)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusActive, item.Status)
+		assert.Equal(t, "bob", item.UpdatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SetItemStatus should refuse items that changed status meanwhile", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("item1").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "item1", 10.0, "Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "archived", nil))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE items SET status = ? WHERE id = ? AND status = ?")).
+			WithArgs("active", itemID.String(), "draft").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.SetItemStatus("item1", entities.StatusDraft, entities.StatusActive, "bob", at)
+		assert.ErrorIs(t, err, entities.ErrInvalidTransition)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_Tags(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlCli := &database.SqlCli{Conn: db}
+	repo := repositories.NewItemRepository(sqlCli)
+	itemID := uuid.New()
+	taggedAt := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	t.Run("AddTag should store the tag and touch the item", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE name = ?").
+			WithArgs("Item1").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", "seasonal"))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO item_tags (item_id, tag) VALUES (?, ?) ON CONFLICT(item_id, tag) DO NOTHING")).
+			WithArgs(itemID.String(), "fragile").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE items SET updated_at = ?, updated_by = ? WHERE id = ?")).
+			WithArgs(taggedAt, "bob", itemID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WithArgs("item.updated", itemID.String(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		item, added, err := repo.AddTag("Item1", "fragile", "bob", taggedAt)
+		assert.NoError(t, err)
+		assert.True(t, added)
+		assert.Equal(t, []string{"fragile", "seasonal"}, item.Tags)
+		assert.Equal(t, "bob", item.UpdatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AddTag should leave an already tagged item alone", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE name = ?").
+			WithArgs("Item1").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", "fragile"))
+		mock.ExpectExec("INSERT INTO item_tags").
+			WithArgs(itemID.String(), "fragile").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		item, added, err := repo.AddTag("Item1", "fragile", "bob", taggedAt)
+		assert.NoError(t, err)
+		assert.False(t, added)
+		assert.Equal(t, "alice", item.UpdatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RemoveTag should report a missing tag", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM items WHERE name = ?").
+			WithArgs("Item1").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM item_tags WHERE item_id = ? AND tag = ?")).
+			WithArgs(itemID.String(), "fragile").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.RemoveTag("Item1", "fragile", "bob", taggedAt)
+		assert.ErrorIs(t, err, entities.ErrTagNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListTags should return usage counts", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT tag, COUNT(*) FROM item_tags GROUP BY tag ORDER BY COUNT(*) DESC, tag")).
+			WillReturnRows(sqlmock.NewRows([]string{"tag", "count"}).AddRow("seasonal", 3).AddRow("fragile", 1))
+
+		tags, err := repo.ListTags()
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.TagCount{{Tag: "seasonal", Count: 3}, {Tag: "fragile", Count: 1}}, tags)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_StreamItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlCli := &database.SqlCli{Conn: db}
+	repo := repositories.NewItemRepository(sqlCli)
+
+	t.Run("StreamItems should yield every row", func(t *testing.T) {
+		rows := sqlmock.NewRows(itemColumns).
+			AddRow(uuid.New().String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil).
+			AddRow(uuid.New().String(), "Item2", 150.0, "Description2", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil)
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items").
+			WillReturnRows(rows)
+
+		var names []string
+		err := repo.StreamItems(nil, func(item *entities.Item) error {
+			names = append(names, item.Name)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Item1", "Item2"}, names)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("StreamItems should filter by status", func(t *testing.T) {
+		rows := sqlmock.NewRows(itemColumns).
+			AddRow(uuid.New().String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "draft", nil)
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items WHERE status IN (?, ?)")).
+			WithArgs("draft", "archived").
+			WillReturnRows(rows)
+
+		var names []string
+		err := repo.StreamItems([]string{"draft", "archived"}, func(item *entities.Item) error {
+			names = append(names, item.Name)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Item1"}, names)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("StreamItems should stop on callback error", func(t *testing.T) {
+		rows := sqlmock.NewRows(itemColumns).
+			AddRow(uuid.New().String(), "Item1", 100.0, "Description1", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil).
+			AddRow(uuid.New().String(), "Item2", 150.0, "Description2", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil)
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items").
+			WillReturnRows(rows)
+
+		calls := 0
+		err := repo.StreamItems(nil, func(item *entities.Item) error {
+			calls++
+			return errors.New("write failed")
+		})
+		assert.EqualError(t, err, "write failed")
+		assert.Equal(t, 1, calls)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_ImportItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlCli := &database.SqlCli{Conn: db}
+	repo := repositories.NewItemRepository(sqlCli)
+
+	item1, _ := entities.NewItem("Item1", 10.0, "Description1")
+	item2, _ := entities.NewItem("Item2", 20.0, "Description2")
+
+	t.Run("ImportItems should insert all items in one transaction", func(t *testing.T) {
+		mock.ExpectBegin()
+		prep := mock.ExpectPrepare("INSERT INTO items")
+		prep.ExpectExec().WithArgs(item1.ID.String(), item1.Name, item1.Price, item1.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), entities.BaseCurrency, "", nil, entities.StatusActive).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO item_prices").
+			WithArgs(sqlmock.AnyArg(), item1.ID.String(), item1.Price, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WithArgs("item.created", item1.ID.String(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		prep.ExpectExec().WithArgs(item2.ID.String(), item2.Name, item2.Price, item2.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), entities.BaseCurrency, "", nil, entities.StatusActive).
+			WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec("INSERT INTO item_prices").
+			WithArgs(sqlmock.AnyArg(), item2.ID.String(), item2.Price, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WithArgs("item.created", item2.ID.String(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err := repo.ImportItems([]*entities.Item{item1, item2})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ImportItems should roll back when an insert fails", func(t *testing.T) {
+		mock.ExpectBegin()
+		prep := mock.ExpectPrepare("INSERT INTO items")
+		prep.ExpectExec().WithArgs(item1.ID.String(), item1.Name, item1.Price, item1.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), entities.BaseCurrency, "", nil, entities.StatusActive).
+			WillReturnError(errors.New("database error"))
+		mock.ExpectRollback()
+
+		err := repo.ImportItems([]*entities.Item{item1, item2})
+		assert.EqualError(t, err, "failed to insert item 'Item1': database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_CreateItemOutbox(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlCli := &database.SqlCli{Conn: db}
+	repo := repositories.NewItemRepository(sqlCli)
+
+	t.Run("CreateItem should roll back the item when the outbox insert fails", func(t *testing.T) {
+		item := &entities.Item{
+			Name:        "NewItem",
+			Price:       200.0,
+			Description: "Description for NewItem",
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO items").
+			WithArgs(sqlmock.AnyArg(), item.Name, item.Price, item.Description, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), entities.BaseCurrency, "", nil, entities.StatusActive).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO item_prices").
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), item.Price, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WillReturnError(errors.New("database error"))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateItem(item)
+		assert.EqualError(t, err, "failed to insert outbox event: database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemRepository_GetCollectionVersion(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sqlCli := &database.SqlCli{Conn: db}
+	repo := repositories.NewItemRepository(sqlCli)
+
+	t.Run("GetCollectionVersion should return the items version", func(t *testing.T) {
+		mock.ExpectQuery("SELECT version, updated_at FROM collection_versions").
+			WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(int64(7), updatedAt))
+
+		version, err := repo.GetCollectionVersion()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), version.Version)
+		assert.Equal(t, updatedAt, version.UpdatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetCollectionVersion should handle database error", func(t *testing.T) {
+		mock.ExpectQuery("SELECT version, updated_at FROM collection_versions").
+			WillReturnError(errors.New("database error"))
+
+		_, err := repo.GetCollectionVersion()
+		assert.EqualError(t, err, "failed to get items version: database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
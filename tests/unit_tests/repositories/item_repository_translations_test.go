@@ -0,0 +1,94 @@
+package repositories_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+func TestItemRepository_Translations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	itemID := uuid.New()
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("TranslationsIn should group translations by item and locale", func(t *testing.T) {
+		otherID := uuid.New()
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_translations WHERE item_id IN (?, ?) AND locale IN (?, ?)")).
+			WithArgs(itemID.String(), otherID.String(), "pt", "es").
+			WillReturnRows(sqlmock.NewRows([]string{"item_id", "locale", "name", "description", "updated_at", "updated_by"}).
+				AddRow(itemID.String(), "pt", "Item um", "Descrição", at, "alice").
+				AddRow(itemID.String(), "es", "Artículo uno", "Descripción", at, "alice").
+				AddRow(otherID.String(), "es", "Artículo dos", "Descripción", at, "bob"))
+
+		translations, err := repo.TranslationsIn([]uuid.UUID{itemID, otherID}, []string{"pt", "es"})
+		assert.NoError(t, err)
+		assert.Len(t, translations[itemID], 2)
+		assert.Equal(t, "Item um", translations[itemID]["pt"].Name)
+		assert.Equal(t, "bob", translations[otherID]["es"].UpdatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("TranslationsIn should not query without locales", func(t *testing.T) {
+		translations, err := repo.TranslationsIn([]uuid.UUID{itemID}, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("TranslationsIn should not query without items", func(t *testing.T) {
+		translations, err := repo.TranslationsIn(nil, []string{"pt"})
+		assert.NoError(t, err)
+		assert.Empty(t, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SetTranslation should upsert and stamp the item", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("item1").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "item1", 10.0, "Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+		mock.ExpectExec("INSERT INTO item_translations (.+) ON CONFLICT\\(item_id, locale\\) DO UPDATE").
+			WithArgs(itemID.String(), "pt", "Item um", "Descrição", at, "bob").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE items SET updated_at = \\?, updated_by = \\?").
+			WithArgs(at, "bob", itemID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		translated, err := repo.SetTranslation("item1", &entities.Translation{Locale: "pt", Name: "Item um", Description: "Descrição", UpdatedAt: at, UpdatedBy: "bob"})
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", translated.UpdatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteTranslation should report missing translations", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("item1").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "item1", 10.0, "Description", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM item_translations WHERE item_id = ? AND locale = ?")).
+			WithArgs(itemID.String(), "fr").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.DeleteTranslation("item1", "fr", "bob", at)
+		assert.ErrorIs(t, err, entities.ErrTranslationNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,135 @@
+package repositories_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+func TestItemRepository_Variants(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemRepository(&database.SqlCli{Conn: db})
+	itemID := uuid.New()
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	columns := append(append([]string{}, itemColumns...), "id", "sku", "options", "price", "created_at", "created_by", "updated_at", "updated_by")
+
+	t.Run("GetItemWithVariants should load the item and its variants in one query", func(t *testing.T) {
+		redID, blueID := uuid.New(), uuid.New()
+		mock.ExpectQuery(regexp.QuoteMeta("FROM items LEFT JOIN item_variants ON item_variants.item_id = items.id WHERE items.name = ?")).
+			WithArgs("shirt").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(itemID.String(), "shirt", 20.0, "Shirt", updatedAt, "alice", updatedAt, "alice", "EUR", "", nil, "active", "cotton",
+					blueID.String(), "TS-BLUE", `{"colour":"blue","size":"M"}`, nil, at, "bob", at, "bob").
+				AddRow(itemID.String(), "shirt", 20.0, "Shirt", updatedAt, "alice", updatedAt, "alice", "EUR", "", nil, "active", "cotton",
+					redID.String(), "TS-RED", `{"colour":"red","size":"M"}`, 25.0, at, "bob", at, "bob"))
+
+		item, err := repo.GetItemWithVariants("shirt")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cotton"}, item.Tags)
+		require.Len(t, item.Variants, 2)
+		assert.Equal(t, blueID, item.Variants[0].ID)
+		assert.Equal(t, []entities.VariantOption{{Name: "colour", Value: "blue"}, {Name: "size", Value: "M"}}, item.Variants[0].Options)
+		assert.Nil(t, item.Variants[0].Price)
+		assert.Equal(t, 20.0, item.Variants[0].EffectivePrice)
+		assert.Equal(t, 25.0, item.Variants[1].EffectivePrice)
+		assert.Equal(t, "EUR", item.Variants[1].Currency)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetItemWithVariants should return items without variants", func(t *testing.T) {
+		mock.ExpectQuery("FROM items LEFT JOIN item_variants").
+			WithArgs("mug").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(itemID.String(), "mug", 8.0, "Mug", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil,
+					nil, nil, nil, nil, nil, nil, nil, nil))
+
+		item, err := repo.GetItemWithVariants("mug")
+		require.NoError(t, err)
+		assert.Equal(t, "mug", item.Name)
+		assert.Empty(t, item.Variants)
+		assert.NotNil(t, item.Variants)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetItemWithVariants should report missing items", func(t *testing.T) {
+		mock.ExpectQuery("FROM items LEFT JOIN item_variants").
+			WithArgs("missing").
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err := repo.GetItemWithVariants("missing")
+		assert.ErrorIs(t, err, entities.ErrItemNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SaveVariant should upsert and stamp the item", func(t *testing.T) {
+		price := 25.0
+		v := &entities.Variant{ID: uuid.New(), SKU: "TS-RED", Options: []entities.VariantOption{{Name: "size", Value: "M"}, {Name: "colour", Value: "red"}},
+			Price: &price, CreatedAt: at, CreatedBy: "bob", UpdatedAt: at, UpdatedBy: "bob"}
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("shirt").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "shirt", 20.0, "Shirt", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT item_id FROM item_variants WHERE sku = ?")).
+			WithArgs("TS-RED").
+			WillReturnRows(sqlmock.NewRows([]string{"item_id"}))
+		mock.ExpectExec("INSERT INTO item_variants (.+) ON CONFLICT\\(sku\\) DO UPDATE").
+			WithArgs(itemID.String(), v.ID.String(), "TS-RED", `{"colour":"red","size":"M"}`, 25.0, at, "bob", at, "bob").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE items SET updated_at = \\?, updated_by = \\?").
+			WithArgs(at, "bob", itemID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO outbox_events").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		saved, err := repo.SaveVariant("shirt", v)
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", saved.UpdatedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SaveVariant should refuse SKUs of other items", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("mug").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "mug", 8.0, "Mug", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT item_id FROM item_variants WHERE sku = ?")).
+			WithArgs("TS-RED").
+			WillReturnRows(sqlmock.NewRows([]string{"item_id"}).AddRow(uuid.New().String()))
+		mock.ExpectRollback()
+
+		_, err := repo.SaveVariant("mug", &entities.Variant{ID: uuid.New(), SKU: "TS-RED", Options: []entities.VariantOption{{Name: "size", Value: "M"}}})
+		assert.ErrorIs(t, err, entities.ErrVariantAlreadyExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteVariant should report missing variants", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, name, price, description, created_at, created_by, updated_at, updated_by, (.+) FROM items WHERE name = ?").
+			WithArgs("shirt").
+			WillReturnRows(sqlmock.NewRows(itemColumns).
+				AddRow(itemID.String(), "shirt", 20.0, "Shirt", updatedAt, "alice", updatedAt, "alice", "USD", "", nil, "active", nil))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM item_variants WHERE item_id = ? AND sku = ?")).
+			WithArgs(itemID.String(), "TS-GREEN").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.DeleteVariant("shirt", "TS-GREEN", "bob", at)
+		assert.ErrorIs(t, err, entities.ErrVariantNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
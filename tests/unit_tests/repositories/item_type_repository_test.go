@@ -0,0 +1,83 @@
+package repositories_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+var itemTypeColumns = []string{"name", "description", "schema", "created_at", "created_by", "updated_at", "updated_by"}
+
+func TestItemTypeRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewItemTypeRepository(&database.SqlCli{Conn: db})
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("SaveItemType should upsert and keep the creation stamp", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO item_types (.+) ON CONFLICT\\(name\\) DO UPDATE SET description = excluded.description, schema = excluded.schema").
+			WithArgs("electronics", "Gadgets", `{"type":"object"}`, at, "alice", at, "bob").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := repo.SaveItemType(&entities.ItemType{
+			Name: "electronics", Description: "Gadgets", Schema: []byte(`{"type":"object"}`),
+			CreatedAt: at, CreatedBy: "alice", UpdatedAt: at, UpdatedBy: "bob",
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetItemType should scan the schema and report missing types", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_types WHERE name = ?")).
+			WithArgs("electronics").
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).
+				AddRow("electronics", "Gadgets", `{"type":"object"}`, at, "alice", at, "bob"))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_types WHERE name = ?")).
+			WithArgs("furniture").
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns))
+
+		itemType, err := repo.GetItemType("electronics")
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"type":"object"}`, string(itemType.Schema))
+
+		_, err = repo.GetItemType("furniture")
+		assert.ErrorIs(t, err, entities.ErrItemTypeNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteItemType should refuse types still assigned to items", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM item_types WHERE name = ? AND NOT EXISTS (SELECT 1 FROM items WHERE type = ?)")).
+			WithArgs("electronics", "electronics").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_types WHERE name = ?")).
+			WithArgs("electronics").
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns).
+				AddRow("electronics", "Gadgets", `{"type":"object"}`, at, "alice", at, "bob"))
+
+		err := repo.DeleteItemType("electronics")
+		assert.ErrorIs(t, err, entities.ErrItemTypeInUse)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteItemType should report missing types", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM item_types WHERE name = ?")).
+			WithArgs("furniture", "furniture").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM item_types WHERE name = ?")).
+			WithArgs("furniture").
+			WillReturnRows(sqlmock.NewRows(itemTypeColumns))
+
+		err := repo.DeleteItemType("furniture")
+		assert.ErrorIs(t, err, entities.ErrItemTypeNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
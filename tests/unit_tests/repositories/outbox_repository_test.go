@@ -0,0 +1,62 @@
+package repositories_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+func TestOutboxRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewOutboxRepository(&database.SqlCli{Conn: db})
+	before := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("MarkDelivered should clear the event's publications", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE outbox_events SET delivered_at = ?")).
+			WithArgs(before, int64(5)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM outbox_publications WHERE event_id = ?")).
+			WithArgs(int64(5)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.MarkDelivered(5, before))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("MarkPublished should ignore targets that were already recorded", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT OR IGNORE INTO outbox_publications (event_id, target, published_at) VALUES (?, ?, ?)")).
+			WithArgs(int64(5), "bus", before).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT target FROM outbox_publications WHERE event_id = ?")).
+			WithArgs(int64(5)).
+			WillReturnRows(sqlmock.NewRows([]string{"target"}).AddRow("bus").AddRow("webhooks"))
+
+		assert.NoError(t, repo.MarkPublished(5, "bus", before))
+		targets, err := repo.PublishedTargets(5)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"bus", "webhooks"}, targets)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("PurgeDelivered should only delete delivered events older than the cutoff", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM outbox_events WHERE delivered_at IS NOT NULL AND delivered_at < ?")).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		purged, err := repo.PurgeDelivered(before)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), purged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,51 @@
+package repositories_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/afornagieri/go_api_template/internal/infra/database"
+	"github.com/afornagieri/go_api_template/internal/infra/repositories"
+)
+
+func TestWebhookRepository(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := repositories.NewWebhookRepository(&database.SqlCli{Conn: db})
+	before := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("EnqueueDeliveries should skip events already enqueued for a webhook", func(t *testing.T) {
+		hookID := uuid.New()
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta("FROM webhooks WHERE active = 1")).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "url", "events", "secret", "active", "created_at"}).
+				AddRow(hookID.String(), "https://partner.example.com/hooks", `["item.created"]`, "secret", true, before))
+		mock.ExpectExec(regexp.QuoteMeta("ON CONFLICT(webhook_id, event_id) DO NOTHING")).
+			WithArgs(sqlmock.AnyArg(), hookID.String(), int64(7), "item.created", []byte(`{}`), "pending", before, before, before).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		enqueued, err := repo.EnqueueDeliveries(7, "item.created", []byte(`{}`), before)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, enqueued)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("PurgeDelivered should only delete delivered rows older than the cutoff", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM webhook_deliveries WHERE status = ? AND updated_at < ?")).
+			WithArgs("delivered", before).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		purged, err := repo.PurgeDelivered(before)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
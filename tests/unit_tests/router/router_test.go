@@ -0,0 +1,173 @@
+package router_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	controller "github.com/afornagieri/go_api_template/internal/adapter/controllers"
+	"github.com/afornagieri/go_api_template/internal/adapter/httpcache"
+	"github.com/afornagieri/go_api_template/internal/adapter/middlewares"
+	"github.com/afornagieri/go_api_template/internal/adapter/openapi"
+	"github.com/afornagieri/go_api_template/internal/adapter/router"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/internal/infra/di"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+var undocumentedRoutes = map[string]bool{
+	"GET /openapi.json":     true,
+	"GET /docs":             true,
+	"GET /docs/assets/*":    true,
+	"GET /graphql/assets/*": true,
+}
+
+func setupContainer() *di.Container {
+	itemRepository := mocks.NewMockItemRepository()
+	itemUseCase := usecases.NewItemUseCase(itemRepository)
+	itemController := controller.NewItemController(itemUseCase)
+	currencyUseCase := usecases.NewCurrencyUseCase(mocks.NewMockCurrencyRepository(), itemRepository)
+	itemController.Currencies = currencyUseCase
+	document := openapi.NewDocument(itemController.Representations.MediaTypes())
+
+	return &di.Container{
+		ItemController:       itemController,
+		GraphQLController:    controller.NewGraphQLController(itemUseCase),
+		EventsController:     controller.NewEventsController(itemUseCase.Events),
+		WebSocket:            controller.NewWebSocketController(itemUseCase.Events),
+		WebhookController:    controller.NewWebhookController(usecases.NewWebhookUseCase(mocks.NewMockWebhookRepository())),
+		CategoryController:   controller.NewCategoryController(usecases.NewCategoryUseCase(mocks.NewMockCategoryRepository(itemRepository), itemRepository)),
+		StockController:      controller.NewStockController(usecases.NewStockUseCase(mocks.NewMockStockRepository(), itemRepository)),
+		CurrencyController:   controller.NewCurrencyController(currencyUseCase),
+		AttachmentController: controller.NewAttachmentController(usecases.NewAttachmentUseCase(mocks.NewMockAttachmentRepository(), itemRepository, mocks.NewMockBlobStore())),
+		ItemTypeController:   controller.NewItemTypeController(usecases.NewItemTypeUseCase(mocks.NewMockItemTypeRepository(itemRepository), itemRepository)),
+		DocsController:       controller.NewDocsController(document),
+		Idempotency:          middlewares.NewIdempotency(mocks.NewMockIdempotencyRepository()),
+		RequestValidation:    middlewares.NewRequestValidation(document, itemController.Representations),
+		CacheControl:         middlewares.NewCacheControl(map[string]httpcache.Policy{"GET /items": {Public: true, MaxAge: time.Minute}}),
+		OpenAPI:              document,
+	}
+}
+
+func newRouter(container *di.Container) *chi.Mux {
+	return router.NewRouter(router.Controllers{
+		Items:       container.ItemController,
+		Events:      container.EventsController,
+		WebSocket:   container.WebSocket,
+		GraphQL:     container.GraphQLController,
+		Webhooks:    container.WebhookController,
+		Categories:  container.CategoryController,
+		Stock:       container.StockController,
+		Currencies:  container.CurrencyController,
+		Attachments: container.AttachmentController,
+		ItemTypes:   container.ItemTypeController,
+		Docs:        container.DocsController,
+	}, router.Middlewares{
+		RequestValidation: container.RequestValidation,
+		CacheControl:      container.CacheControl,
+		Idempotency:       container.Idempotency,
+	})
+}
+
+func TestRouter_EveryRouteIsDocumented(t *testing.T) {
+	container := setupContainer()
+	r := newRouter(container)
+
+	err := chi.Walk(r, func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		if undocumentedRoutes[method+" "+route] {
+			return nil
+		}
+		assert.True(t, container.OpenAPI.HasOperation(method, route), "route %s %s is missing from the OpenAPI document", method, route)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestRouter_EveryDocumentedOperationIsRouted(t *testing.T) {
+	container := setupContainer()
+	r := newRouter(container)
+
+	routed := map[string]bool{}
+	chi.Walk(r, func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		routed[method+" "+route] = true
+		return nil
+	})
+
+	for path, item := range container.OpenAPI.Paths {
+		for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+			if item.Operation(method) != nil {
+				assert.True(t, routed[method+" "+path], "documented operation %s %s has no route", method, path)
+			}
+		}
+	}
+}
+
+func TestRouter_ServesOpenAPIDocument(t *testing.T) {
+	r := newRouter(setupContainer())
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", "/openapi.json", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var doc map[string]any
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&doc))
+	assert.Equal(t, openapi.Version, doc["openapi"])
+	assert.Contains(t, doc["paths"], "/items/{name}")
+}
+
+func TestRouter_ServesConditionalItemsWithCachePolicy(t *testing.T) {
+	r := newRouter(setupContainer())
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", "/items", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "public, max-age=60", recorder.Header().Get("Cache-Control"))
+
+	req := httptest.NewRequest("GET", "/items", nil)
+	req.Header.Set("If-None-Match", recorder.Header().Get("ETag"))
+	recorder = httptest.NewRecorder()
+	r.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusNotModified, recorder.Code)
+	assert.Equal(t, "public, max-age=60", recorder.Header().Get("Cache-Control"))
+}
+
+func TestRouter_ServesSwaggerUI(t *testing.T) {
+	r := newRouter(setupContainer())
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", "/docs", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "/openapi.json")
+	assert.NotContains(t, recorder.Body.String(), "https://")
+
+	recorder = httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", "/docs/assets/swagger-ui-bundle.js", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "SwaggerUIBundle")
+}
+
+func TestRouter_ShouldNotExposeDebugVars(t *testing.T) {
+	r := newRouter(setupContainer())
+
+	recorder := httptest.NewRecorder()
+	r.ServeHTTP(recorder, httptest.NewRequest("GET", "/debug/vars", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestRouter_ServesGraphiQLAssets(t *testing.T) {
+	r := newRouter(setupContainer())
+
+	for _, asset := range []string{"graphiql.min.js", "graphiql.min.css", "react.production.min.js", "react-dom.production.min.js"} {
+		recorder := httptest.NewRecorder()
+		r.ServeHTTP(recorder, httptest.NewRequest("GET", "/graphql/assets/"+asset, nil))
+		assert.Equal(t, http.StatusOK, recorder.Code, asset)
+		assert.NotEmpty(t, recorder.Body.Bytes(), asset)
+	}
+}
@@ -0,0 +1,78 @@
+package usecases_test
+
+import (
+	"testing"
+	"time"
+
+	entities "github.com/afornagieri/go_api_template/internal/domain/entities/item"
+	"github.com/afornagieri/go_api_template/internal/domain/usecases"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixedClock(at time.Time) func() time.Time {
+	return func() time.Time { return at }
+}
+
+func TestCreateItem_ShouldStampActorAndTime(t *testing.T) {
+	usecase := usecases.NewItemUseCase(mocks.NewMockItemRepository())
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	usecase.Now = fixedClock(now)
+
+	forged := time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)
+	item := &entities.Item{Name: "Item", Price: 10.0, Description: "Description", CreatedAt: forged, CreatedBy: "mallory", UpdatedBy: "mallory"}
+
+	created, err := usecase.WithActor("alice").CreateItem(item)
+	assert.NoError(t, err)
+	assert.Equal(t, now, created.CreatedAt)
+	assert.Equal(t, now, created.UpdatedAt)
+	assert.Equal(t, "alice", created.CreatedBy)
+	assert.Equal(t, "alice", created.UpdatedBy)
+}
+
+func TestCreateItem_ShouldDefaultToAnonymousActor(t *testing.T) {
+	usecase := usecases.NewItemUseCase(mocks.NewMockItemRepository())
+
+	created, err := usecase.CreateItem(&entities.Item{Name: "Item", Price: 10.0, Description: "Description"})
+	assert.NoError(t, err)
+	assert.Equal(t, usecases.AnonymousActor, created.CreatedBy)
+	assert.False(t, created.CreatedAt.IsZero())
+}
+
+func TestUpdateItem_ShouldKeepCreationStampAndRecordUpdater(t *testing.T) {
+	usecase := usecases.NewItemUseCase(mocks.NewMockItemRepository())
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	usecase.Now = fixedClock(createdAt)
+	usecase.WithActor("alice").CreateItem(&entities.Item{Name: "Item", Price: 10.0, Description: "Description"})
+
+	updatedAt := createdAt.Add(time.Hour)
+	usecase.Now = fixedClock(updatedAt)
+	updated, err := usecase.WithActor("bob").UpdateItem("Item", &entities.Item{Name: "Item", Price: 15.0, Description: "Description", CreatedBy: "mallory"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, createdAt, updated.CreatedAt)
+	assert.Equal(t, "alice", updated.CreatedBy)
+	assert.Equal(t, updatedAt, updated.UpdatedAt)
+	assert.Equal(t, "bob", updated.UpdatedBy)
+}
+
+func TestImportItems_ShouldStampEveryItem(t *testing.T) {
+	usecase := usecases.NewItemUseCase(mocks.NewMockItemRepository())
+	item1, _ := entities.NewItem("Item1", 10.0, "Description1")
+	item2, _ := entities.NewItem("Item2", 20.0, "Description2")
+
+	err := usecase.WithActor("importer").ImportItems([]*entities.Item{item1, item2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "importer", item1.CreatedBy)
+	assert.Equal(t, item1.CreatedAt, item2.CreatedAt)
+}
+
+func TestWithActor_ShouldNotChangeTheSharedUseCase(t *testing.T) {
+	usecase := usecases.NewItemUseCase(mocks.NewMockItemRepository())
+
+	usecase.WithActor("alice")
+	created, _ := usecase.CreateItem(&entities.Item{Name: "Item", Price: 10.0, Description: "Description"})
+
+	assert.Equal(t, usecases.AnonymousActor, created.CreatedBy)
+}
@@ -43,7 +43,7 @@ func TestGetItemByName_ShouldReturnError(t *testing.T) {
 
 	item := &entities.Item{Name: "Item", Price: 10.0, Description: "Description"}
 
-	err := usecase.CreateItem(item)
+	_, err := usecase.CreateItem(item)
 	assert.NoError(t, err)
 
 	_, err = usecase.GetItemByName("Item1")
@@ -57,7 +57,7 @@ func TestCreateItem(t *testing.T) {
 
 	item := &entities.Item{Name: "Item", Price: 10.0, Description: "Description"}
 
-	err := usecase.CreateItem(item)
+	_, err := usecase.CreateItem(item)
 	assert.NoError(t, err)
 
 	item, err = usecase.GetItemByName(item.Name)
@@ -71,10 +71,10 @@ func TestCreateItem_ShouldReturnError(t *testing.T) {
 
 	item := &entities.Item{Name: "Item", Price: 10.0, Description: "Description"}
 
-	err := usecase.CreateItem(item)
+	_, err := usecase.CreateItem(item)
 	assert.NoError(t, err)
 
-	err = usecase.CreateItem(item)
+	_, err = usecase.CreateItem(item)
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "item already exists")
 }
@@ -85,7 +85,7 @@ func TestUpdateItem(t *testing.T) {
 
 	oldItem := &entities.Item{Name: "Item1", Price: 10.0, Description: "Description1"}
 
-	err := usecase.CreateItem(oldItem)
+	_, err := usecase.CreateItem(oldItem)
 	assert.NoError(t, err)
 
 	item, err := usecase.GetItemByName(oldItem.Name)
@@ -93,7 +93,7 @@ func TestUpdateItem(t *testing.T) {
 	assert.NoError(t, err)
 
 	newItem := &entities.Item{Name: "Item2", Price: 20.0, Description: "Description2"}
-	err = usecase.UpdateItem(oldItem.Name, newItem)
+	_, err = usecase.UpdateItem(oldItem.Name, newItem)
 	assert.NoError(t, err)
 }
 
@@ -103,25 +103,57 @@ func TestUpdateItem_ShouldReturnError(t *testing.T) {
 
 	item := &entities.Item{Name: "Item", Price: 10.0, Description: "Description"}
 
-	err := usecase.CreateItem(item)
+	_, err := usecase.CreateItem(item)
 	assert.NoError(t, err)
 
 	item, err = usecase.GetItemByName("Item")
 	assert.NotNil(t, item)
 	assert.NoError(t, err)
 
-	err = usecase.UpdateItem("item1", item)
+	_, err = usecase.UpdateItem("item1", item)
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "item not found")
 }
 
+func TestCreateItem_ShouldValidateItem(t *testing.T) {
+	mockRepo := mocks.NewMockItemRepository()
+	usecase := usecases.NewItemUseCase(mockRepo)
+
+	_, err := usecase.CreateItem(&entities.Item{Name: "", Price: 10.0, Description: "Description"})
+	assert.ErrorIs(t, err, entities.ErrInvalidItem)
+
+	_, err = usecase.CreateItem(&entities.Item{Name: "Item", Price: 0, Description: "Description"})
+	assert.ErrorIs(t, err, entities.ErrInvalidItem)
+
+	items, _ := usecase.GetItems()
+	assert.Empty(t, items)
+}
+
+func TestUpdateItem_ShouldValidateItem(t *testing.T) {
+	mockRepo := mocks.NewMockItemRepository()
+	usecase := usecases.NewItemUseCase(mockRepo)
+
+	_, err := usecase.CreateItem(&entities.Item{Name: "Item", Price: 10.0, Description: "Description"})
+	assert.NoError(t, err)
+
+	_, err = usecase.UpdateItem("Item", &entities.Item{Name: "", Price: 10.0, Description: "Description"})
+	assert.ErrorIs(t, err, entities.ErrInvalidItem)
+
+	_, err = usecase.UpdateItem("Item", &entities.Item{Name: "Item", Price: -1, Description: "Description"})
+	assert.ErrorIs(t, err, entities.ErrInvalidItem)
+
+	item, err := usecase.GetItemByName("Item")
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, item.Price)
+}
+
 func TestDeleteItem(t *testing.T) {
 	mockRepo := mocks.NewMockItemRepository()
 	usecase := usecases.NewItemUseCase(mockRepo)
 
 	item := &entities.Item{Name: "Item", Price: 10.0, Description: "Description"}
 
-	err := usecase.CreateItem(item)
+	_, err := usecase.CreateItem(item)
 	assert.NoError(t, err)
 
 	item, err = usecase.GetItemByName(item.Name)
@@ -138,7 +170,7 @@ func TestDeleteItem_ShouldReturnError(t *testing.T) {
 
 	item := &entities.Item{Name: "Item", Price: 10.0, Description: "Description"}
 
-	err := usecase.CreateItem(item)
+	_, err := usecase.CreateItem(item)
 	assert.NoError(t, err)
 
 	item, err = usecase.GetItemByName(item.Name)
@@ -149,3 +181,41 @@ func TestDeleteItem_ShouldReturnError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, err.Error(), "item not found")
 }
+
+func TestListItems_ShouldDefaultToActiveItems(t *testing.T) {
+	mockRepo := mocks.NewMockItemRepository()
+	usecase := usecases.NewItemUseCase(mockRepo)
+
+	usecase.CreateItem(&entities.Item{Name: "Item1", Price: 10.0, Description: "Description1"})
+	usecase.CreateItem(&entities.Item{Name: "Item2", Price: 20.0, Description: "Description2", Status: entities.StatusDraft})
+
+	items, err := usecase.ListItems(entities.ItemQuery{})
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "Item1", items[0].Name)
+
+	items, err = usecase.ListItems(entities.ItemQuery{Statuses: entities.Statuses})
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+}
+
+func TestExportItems_ShouldDefaultToActiveItems(t *testing.T) {
+	mockRepo := mocks.NewMockItemRepository()
+	usecase := usecases.NewItemUseCase(mockRepo)
+
+	usecase.CreateItem(&entities.Item{Name: "Item1", Price: 10.0, Description: "Description1"})
+	usecase.CreateItem(&entities.Item{Name: "Item2", Price: 20.0, Description: "Description2", Status: entities.StatusDraft})
+
+	var names []string
+	collect := func(item *entities.Item) error {
+		names = append(names, item.Name)
+		return nil
+	}
+
+	assert.NoError(t, usecase.ExportItems(nil, collect))
+	assert.Equal(t, []string{"Item1"}, names)
+
+	names = nil
+	assert.NoError(t, usecase.ExportItems([]string{entities.StatusDraft}, collect))
+	assert.Equal(t, []string{"Item2"}, names)
+}
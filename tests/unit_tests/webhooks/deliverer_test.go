@@ -0,0 +1,181 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/afornagieri/go_api_template/internal/domain/entities/webhook"
+	"github.com/afornagieri/go_api_template/internal/infra/outbox"
+	"github.com/afornagieri/go_api_template/internal/infra/webhooks"
+	"github.com/afornagieri/go_api_template/tests/unit_tests/mocks"
+)
+
+type receivedRequest struct {
+	Header http.Header
+	Body   []byte
+}
+
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []receivedRequest
+}
+
+func newReceiver(t *testing.T, status int) (*receiver, *httptest.Server) {
+	rec := &receiver{status: status}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rec.mu.Lock()
+		rec.requests = append(rec.requests, receivedRequest{Header: r.Header.Clone(), Body: body})
+		status := rec.status
+		rec.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return rec, server
+}
+
+func (rec *receiver) received() []receivedRequest {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]receivedRequest(nil), rec.requests...)
+}
+
+func setupDeliverer(t *testing.T, url string, events ...string) (*webhooks.Deliverer, *webhooks.Enqueuer, *mocks.MockWebhookRepository, *webhook.Webhook, *time.Time) {
+	repo := mocks.NewMockWebhookRepository()
+	hook, err := webhook.NewWebhook(webhook.Input{URL: url, Events: events})
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateWebhook(hook))
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	deliverer := webhooks.NewDeliverer(repo)
+	deliverer.Now = clock
+	enqueuer := webhooks.NewEnqueuer(repo)
+	enqueuer.Now = clock
+	return deliverer, enqueuer, repo, hook, &now
+}
+
+func publish(t *testing.T, enqueuer *webhooks.Enqueuer, id int64, eventType string) {
+	msg := outbox.Message{ID: id, Type: eventType, AggregateID: "a", Payload: json.RawMessage(`{"name":"item1"}`), OccurredAt: time.Now().UTC()}
+	require.NoError(t, enqueuer.Publish(context.Background(), msg))
+}
+
+func TestDeliverer_ShouldSendSignedPayload(t *testing.T) {
+	rec, server := newReceiver(t, http.StatusNoContent)
+	deliverer, enqueuer, repo, hook, _ := setupDeliverer(t, server.URL)
+	publish(t, enqueuer, 1, "item.created")
+
+	sent, err := deliverer.DeliverDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	requests := rec.received()
+	require.Len(t, requests, 1)
+	req := requests[0]
+	assert.Equal(t, "item.created", req.Header.Get(webhooks.EventHeader))
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.True(t, webhooks.Verify(hook.Secret, req.Header.Get(webhooks.TimestampHeader), req.Body, req.Header.Get(webhooks.SignatureHeader)))
+	assert.False(t, webhooks.Verify("another-secret-value", req.Header.Get(webhooks.TimestampHeader), req.Body, req.Header.Get(webhooks.SignatureHeader)))
+
+	var payload webhooks.Payload
+	require.NoError(t, json.Unmarshal(req.Body, &payload))
+	assert.Equal(t, int64(1), payload.EventID)
+	assert.JSONEq(t, `{"name":"item1"}`, string(payload.Data))
+
+	deliveries, _ := repo.ListDeliveries(hook.ID, 10)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, webhook.DeliveryDelivered, deliveries[0].Status)
+	assert.Equal(t, http.StatusNoContent, deliveries[0].ResponseStatus)
+	assert.Equal(t, req.Header.Get(webhooks.DeliveryHeader), deliveries[0].ID.String())
+}
+
+func TestDeliverer_ShouldRetryWithBackoffAndDeadLetter(t *testing.T) {
+	rec, server := newReceiver(t, http.StatusInternalServerError)
+	deliverer, enqueuer, repo, hook, now := setupDeliverer(t, server.URL)
+	deliverer.MaxAttempts = 3
+	publish(t, enqueuer, 1, "item.updated")
+
+	deliverer.DeliverDue(context.Background())
+	deliveries, _ := repo.ListDeliveries(hook.ID, 10)
+	failed := deliveries[0]
+	assert.Equal(t, webhook.DeliveryPending, failed.Status)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, failed.ResponseStatus)
+	assert.Equal(t, "unexpected response status 500", failed.LastError)
+	assert.Equal(t, now.Add(30*time.Second), *failed.NextAttemptAt)
+
+	sent, _ := deliverer.DeliverDue(context.Background())
+	assert.Equal(t, 0, sent)
+
+	*now = now.Add(30 * time.Second)
+	deliverer.DeliverDue(context.Background())
+	assert.Equal(t, now.Add(time.Minute), *repo.Delivery(failed.ID).NextAttemptAt)
+
+	*now = now.Add(time.Minute)
+	deliverer.DeliverDue(context.Background())
+	dead, _ := repo.ListDeadLetters(10)
+	require.Len(t, dead, 1)
+	assert.Equal(t, webhook.DeliveryDead, dead[0].Status)
+	assert.Equal(t, 3, dead[0].Attempts)
+	assert.Len(t, rec.received(), 3)
+
+	*now = now.Add(time.Hour)
+	sent, _ = deliverer.DeliverDue(context.Background())
+	assert.Equal(t, 0, sent)
+}
+
+func TestDeliverer_ShouldPurgeDeliveredPastRetention(t *testing.T) {
+	_, server := newReceiver(t, http.StatusOK)
+	deliverer, enqueuer, repo, hook, now := setupDeliverer(t, server.URL)
+	deliverer.Retention = 24 * time.Hour
+	publish(t, enqueuer, 1, "item.created")
+	deliverer.DeliverDue(context.Background())
+
+	*now = now.Add(12 * time.Hour)
+	publish(t, enqueuer, 2, "item.updated")
+	deliverer.DeliverDue(context.Background())
+	publish(t, enqueuer, 3, "item.deleted")
+
+	*now = now.Add(13 * time.Hour)
+	purged, err := deliverer.PurgeDelivered()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	deliveries, _ := repo.ListDeliveries(hook.ID, 10)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, int64(3), deliveries[0].EventID)
+	assert.Equal(t, webhook.DeliveryPending, deliveries[0].Status)
+	assert.Equal(t, int64(2), deliveries[1].EventID)
+}
+
+func TestEnqueuer_ShouldOnlyEnqueueSubscribedEventsOnce(t *testing.T) {
+	_, enqueuer, repo, hook, _ := setupDeliverer(t, "https://partner.example.com/hooks", "item.deleted")
+
+	publish(t, enqueuer, 1, "item.created")
+	publish(t, enqueuer, 2, "item.deleted")
+	publish(t, enqueuer, 2, "item.deleted")
+
+	deliveries, _ := repo.ListDeliveries(hook.ID, 10)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, int64(2), deliveries[0].EventID)
+	assert.Equal(t, "item.deleted", deliveries[0].EventType)
+}
+
+func TestSign_ShouldMatchKnownVector(t *testing.T) {
+	signature := webhooks.Sign("secret", 1700000000, []byte(`{}`))
+
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Len(t, signature, 7+64)
+	assert.True(t, webhooks.Verify("secret", "1700000000", []byte(`{}`), signature))
+	assert.False(t, webhooks.Verify("secret", "1700000001", []byte(`{}`), signature))
+}